@@ -0,0 +1,25 @@
+package billing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseAmount converts a monetary amount returned by the API as a string,
+// e.g. "23.44" or "-10.00", into a float. The currency symbol and thousands
+// separators used by invoice CSV exports, e.g. "$1,234.56" or "-$5.00", are
+// removed first.
+func parseAmount(amount string) (float64, error) {
+	amount = strings.TrimSpace(amount)
+	if amount == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(strings.NewReplacer("$", "", ",", "").Replace(amount), 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse amount %q: %s", amount, err)
+	}
+
+	return value, nil
+}
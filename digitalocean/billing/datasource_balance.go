@@ -0,0 +1,68 @@
+package billing
+
+import (
+	"context"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanBalance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanBalanceRead,
+		Schema: map[string]*schema.Schema{
+			"month_to_date_balance": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Balance as of the generated_at time, including month-to-date usage.",
+			},
+			"account_balance": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Current balance of the customer's most recent billing activity. Does not reflect month_to_date_usage.",
+			},
+			"month_to_date_usage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Amount used in the current billing period as of the generated_at time.",
+			},
+			"generated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the balance was generated.",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanBalanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	balance, _, err := client.Balance.Get(context.Background())
+	if err != nil {
+		return diag.Errorf("Error retrieving balance: %s", err)
+	}
+
+	monthToDateBalance, err := parseAmount(balance.MonthToDateBalance)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accountBalance, err := parseAmount(balance.AccountBalance)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	monthToDateUsage, err := parseAmount(balance.MonthToDateUsage)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(balance.GeneratedAt.UTC().Format(time.RFC3339))
+	d.Set("month_to_date_balance", monthToDateBalance)
+	d.Set("account_balance", accountBalance)
+	d.Set("month_to_date_usage", monthToDateUsage)
+	d.Set("generated_at", balance.GeneratedAt.UTC().Format(time.RFC3339))
+
+	return nil
+}
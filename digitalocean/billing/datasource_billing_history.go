@@ -0,0 +1,17 @@
+package billing
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanBillingHistory() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        billingHistorySchema(),
+		ResultAttributeName: "billing_history",
+		GetRecords:          getDigitalOceanBillingHistory,
+		FlattenRecord:       flattenDigitalOceanBillingHistoryEntry,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,43 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanBillingHistory_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanBillingHistoryConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_billing_history.invoices", "billing_history.#"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_billing_history.invoices", "billing_history.0.type", "Invoice"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_billing_history.invoices", "billing_history.0.invoice_uuid"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_billing_history.invoices", "billing_history.0.amount"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanBillingHistoryConfig_basic = `
+data "digitalocean_billing_history" "invoices" {
+  filter {
+    key    = "type"
+    values = ["Invoice"]
+  }
+
+  sort {
+    key       = "date"
+    direction = "desc"
+  }
+}`
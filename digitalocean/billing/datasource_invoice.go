@@ -0,0 +1,168 @@
+package billing
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanInvoice() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanInvoiceRead,
+		Schema: map[string]*schema.Schema{
+			"invoice_uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The UUID of the invoice. Defaults to the preview of the current month's invoice.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"billing_period": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The billing period of the invoice, e.g. 2024-01.",
+			},
+			"amount": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total amount of the invoice.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the user the invoice was issued to.",
+			},
+			"user_company": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The company of the user the invoice was issued to.",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the user the invoice was issued to.",
+			},
+			"product_charges":         invoiceBreakdownSchema("Charges on the invoice broken down by product."),
+			"overages":                invoiceBreakdownSchema("Overages on the invoice."),
+			"taxes":                   invoiceBreakdownSchema("Taxes applied to the invoice."),
+			"credits_and_adjustments": invoiceBreakdownSchema("Credits and adjustments applied to the invoice."),
+			"line_items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The line items of the invoice, parsed from its CSV export.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hours": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"project_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanInvoiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	invoiceUUID := d.Get("invoice_uuid").(string)
+	if invoiceUUID == "" {
+		invoices, _, err := client.Invoices.List(context.Background(), &godo.ListOptions{PerPage: 1})
+		if err != nil {
+			return diag.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		invoiceUUID = invoices.InvoicePreview.InvoiceUUID
+		if invoiceUUID == "" {
+			return diag.Errorf("Error retrieving invoice preview: no preview is available for the current billing period")
+		}
+	}
+
+	summary, _, err := client.Invoices.GetSummary(context.Background(), invoiceUUID)
+	if err != nil {
+		return diag.Errorf("Error retrieving invoice summary: %s", err)
+	}
+
+	csv, _, err := client.Invoices.GetCSV(context.Background(), invoiceUUID)
+	if err != nil {
+		return diag.Errorf("Error retrieving invoice CSV: %s", err)
+	}
+
+	lineItems, err := ParseInvoiceCSV(csv)
+	if err != nil {
+		return diag.Errorf("Error parsing invoice CSV: %s", err)
+	}
+
+	amount, err := parseAmount(summary.Amount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(invoiceUUID)
+	d.Set("invoice_uuid", invoiceUUID)
+	d.Set("billing_period", summary.BillingPeriod)
+	d.Set("amount", amount)
+	d.Set("user_name", summary.UserName)
+	d.Set("user_company", summary.UserCompany)
+	d.Set("user_email", summary.UserEmail)
+
+	breakdowns := map[string]godo.InvoiceSummaryBreakdown{
+		"product_charges":         summary.ProductCharges,
+		"overages":                summary.Overages,
+		"taxes":                   summary.Taxes,
+		"credits_and_adjustments": summary.CreditsAndAdjustments,
+	}
+	for key, breakdown := range breakdowns {
+		flattened, err := flattenInvoiceSummaryBreakdown(breakdown)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set(key, flattened); err != nil {
+			return diag.Errorf("Error setting %s: %s", key, err)
+		}
+	}
+
+	if err := d.Set("line_items", flattenInvoiceLineItems(lineItems)); err != nil {
+		return diag.Errorf("Error setting line_items: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package billing_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/billing"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanInvoice_Preview(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanInvoiceConfig_preview,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.preview", "invoice_uuid"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.preview", "billing_period"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.preview", "amount"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_invoice.preview", "product_charges.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanInvoice_ByUUID(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanInvoiceConfig_byUUID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_invoice.last", "invoice_uuid",
+						"data.digitalocean_billing_history.invoices", "billing_history.0.invoice_uuid"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.last", "line_items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseInvoiceCSV(t *testing.T) {
+	// In the format of an invoice CSV export, amounts include the currency
+	// symbol and thousands separators.
+	data := []byte(`product,group_description,description,hours,start,end,USD,project_name,category
+Droplets,,ubuntu-s-1vcpu-1gb-nyc3-01 (s-1vcpu-1gb),744,2024-01-01 00:00:00 +0000,2024-02-01 00:00:00 +0000,$6.00,production,iaas
+Spaces Subscription,,"Spaces (250GB storage, 1TB transfer)",,2024-01-01 00:00:00 +0000,2024-02-01 00:00:00 +0000,$5.00,default,iaas
+Kubernetes Clusters,,k8s-prod (so1_5-32vcpu-256gb),744,2024-01-01 00:00:00 +0000,2024-02-01 00:00:00 +0000,"$1,234.56",production,paas
+Credits,,Promotional credit,,2024-01-01 00:00:00 +0000,2024-02-01 00:00:00 +0000,-$3.11,,
+`)
+
+	expected := []billing.InvoiceLineItem{
+		{
+			Product:     "Droplets",
+			Description: "ubuntu-s-1vcpu-1gb-nyc3-01 (s-1vcpu-1gb)",
+			Hours:       744,
+			Start:       "2024-01-01 00:00:00 +0000",
+			End:         "2024-02-01 00:00:00 +0000",
+			Amount:      6,
+			ProjectName: "production",
+			Category:    "iaas",
+		},
+		{
+			Product:     "Spaces Subscription",
+			Description: "Spaces (250GB storage, 1TB transfer)",
+			Start:       "2024-01-01 00:00:00 +0000",
+			End:         "2024-02-01 00:00:00 +0000",
+			Amount:      5,
+			ProjectName: "default",
+			Category:    "iaas",
+		},
+		{
+			Product:     "Kubernetes Clusters",
+			Description: "k8s-prod (so1_5-32vcpu-256gb)",
+			Hours:       744,
+			Start:       "2024-01-01 00:00:00 +0000",
+			End:         "2024-02-01 00:00:00 +0000",
+			Amount:      1234.56,
+			ProjectName: "production",
+			Category:    "paas",
+		},
+		{
+			Product:     "Credits",
+			Description: "Promotional credit",
+			Start:       "2024-01-01 00:00:00 +0000",
+			End:         "2024-02-01 00:00:00 +0000",
+			Amount:      -3.11,
+		},
+	}
+
+	items, err := billing.ParseInvoiceCSV(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("ParseInvoiceCSV returned %+v, expected %+v", items, expected)
+	}
+
+	items, err = billing.ParseInvoiceCSV([]byte{})
+	if err != nil {
+		t.Fatalf("unexpected error for empty CSV: %s", err)
+	}
+	if len(items) != 0 {
+		t.Errorf("expected no line items for empty CSV, got %+v", items)
+	}
+}
+
+const testAccCheckDataSourceDigitalOceanInvoiceConfig_preview = `
+data "digitalocean_invoice" "preview" {
+}`
+
+const testAccCheckDataSourceDigitalOceanInvoiceConfig_byUUID = `
+data "digitalocean_billing_history" "invoices" {
+  filter {
+    key    = "type"
+    values = ["Invoice"]
+  }
+
+  sort {
+    key       = "date"
+    direction = "desc"
+  }
+}
+
+data "digitalocean_invoice" "last" {
+  invoice_uuid = data.digitalocean_billing_history.invoices.billing_history[0].invoice_uuid
+}`
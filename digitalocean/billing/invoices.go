@@ -0,0 +1,178 @@
+package billing
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// InvoiceLineItem is a single row of an invoice's CSV export.
+type InvoiceLineItem struct {
+	Product          string
+	GroupDescription string
+	Description      string
+	Hours            float64
+	Start            string
+	End              string
+	Amount           float64
+	ProjectName      string
+	Category         string
+}
+
+// ParseInvoiceCSV parses the CSV export of an invoice into line items. Columns
+// are matched by their header so that reordered or additional columns do not
+// break parsing. The amount column is named after the invoice's currency, e.g.
+// "USD".
+func ParseInvoiceCSV(data []byte) ([]InvoiceLineItem, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read invoice CSV header: %s", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	column := func(record []string, names ...string) string {
+		for _, name := range names {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+		}
+		return ""
+	}
+
+	var items []InvoiceLineItem
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read invoice CSV: %s", err)
+		}
+
+		hours, err := parseAmount(column(record, "hours"))
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseAmount(column(record, "usd", "amount"))
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, InvoiceLineItem{
+			Product:          column(record, "product"),
+			GroupDescription: column(record, "group_description"),
+			Description:      column(record, "description"),
+			Hours:            hours,
+			Start:            column(record, "start"),
+			End:              column(record, "end"),
+			Amount:           amount,
+			ProjectName:      column(record, "project_name"),
+			Category:         column(record, "category"),
+		})
+	}
+
+	return items, nil
+}
+
+func invoiceBreakdownSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"amount": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"items": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"amount": {
+								Type:     schema.TypeFloat,
+								Computed: true,
+							},
+							"count": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenInvoiceSummaryBreakdown(breakdown godo.InvoiceSummaryBreakdown) ([]interface{}, error) {
+	amount, err := parseAmount(breakdown.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0, len(breakdown.Items))
+	for _, item := range breakdown.Items {
+		itemAmount, err := parseAmount(item.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, map[string]interface{}{
+			"name":   item.Name,
+			"amount": itemAmount,
+			"count":  item.Count,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":   breakdown.Name,
+			"amount": amount,
+			"items":  items,
+		},
+	}, nil
+}
+
+func flattenInvoiceLineItems(items []InvoiceLineItem) []interface{} {
+	flattened := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		flattened = append(flattened, map[string]interface{}{
+			"product":           item.Product,
+			"group_description": item.GroupDescription,
+			"description":       item.Description,
+			"hours":             item.Hours,
+			"start":             item.Start,
+			"end":               item.End,
+			"amount":            item.Amount,
+			"project_name":      item.ProjectName,
+			"category":          item.Category,
+		})
+	}
+
+	return flattened
+}
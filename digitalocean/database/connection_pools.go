@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseConnectionPoolsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the connection pool.",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Description: "The name of the database user the connection pool uses.",
+		},
+		"mode": {
+			Type:        schema.TypeString,
+			Description: "The PGBouncer transaction mode of the connection pool.",
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Description: "The number of connections in the connection pool.",
+		},
+		"db_name": {
+			Type:        schema.TypeString,
+			Description: "The database the connection pool connects to.",
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Description: "The hostname used to connect to the connection pool.",
+		},
+		"private_host": {
+			Type:        schema.TypeString,
+			Description: "The private hostname used to connect to the connection pool.",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "The port used to connect to the connection pool.",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseConnectionPools(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := extra["cluster_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allPools []interface{}
+
+	for {
+		pools, resp, err := client.Databases.ListPools(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database connection pools: %s", err)
+		}
+
+		for _, pool := range pools {
+			allPools = append(allPools, pool)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database connection pools: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allPools, nil
+}
+
+func flattenDigitalOceanDatabaseConnectionPool(rawPool, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	pool, ok := rawPool.(godo.DatabasePool)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabasePool")
+	}
+
+	flattenedPool := map[string]interface{}{
+		"name":         pool.Name,
+		"user":         pool.User,
+		"mode":         pool.Mode,
+		"size":         pool.Size,
+		"db_name":      pool.Database,
+		"host":         "",
+		"private_host": "",
+		"port":         0,
+	}
+
+	if pool.Connection != nil {
+		flattenedPool["host"] = pool.Connection.Host
+		flattenedPool["port"] = pool.Connection.Port
+	}
+
+	if pool.PrivateConnection != nil {
+		flattenedPool["private_host"] = pool.PrivateConnection.Host
+	}
+
+	return flattenedPool, nil
+}
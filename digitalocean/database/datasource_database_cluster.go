@@ -65,6 +65,19 @@ func DataSourceDigitalOceanDatabaseCluster() *schema.Resource {
 				},
 			},
 
+			"maintenance_update_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether an update is pending installation on the database cluster",
+			},
+
+			"maintenance_update_descriptions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The descriptions of the updates pending installation on the database cluster",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"host": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -228,6 +241,10 @@ func dataSourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Re
 				}
 			}
 
+			if err := setDatabaseMaintenanceUpdates(db.MaintenanceWindow, d); err != nil {
+				return diag.Errorf("Error setting pending updates for database cluster: %s", err)
+			}
+
 			err := setDatabaseConnectionInfo(&db, d)
 			if err != nil {
 				return diag.Errorf("Error setting connection info for database cluster: %s", err)
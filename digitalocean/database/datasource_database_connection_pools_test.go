@@ -0,0 +1,53 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseConnectionPools_Basic(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+	databaseConnectionPoolName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, databaseConnectionPoolName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + testAccCheckDataSourceDigitalOceanDatabaseConnectionPoolsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.foobar", "connection_pools.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.name", databaseConnectionPoolName),
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.mode", "transaction"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.size", "10"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.db_name", "defaultdb"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.user", "doadmin"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.host"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_connection_pools.foobar", "connection_pools.0.port"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseConnectionPoolsConfig = `
+
+data "digitalocean_database_connection_pools" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key    = "mode"
+    values = ["transaction"]
+  }
+
+  depends_on = [digitalocean_database_connection_pool.pool-01]
+}`
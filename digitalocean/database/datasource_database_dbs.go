@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseDBs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseDBsSchema(),
+		ResultAttributeName: "dbs",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the database cluster to list the databases of.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDatabaseDB,
+		GetRecords:    getDigitalOceanDatabaseDBs,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
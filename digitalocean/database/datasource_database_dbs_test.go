@@ -0,0 +1,62 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseDBs_Basic(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	databaseDBName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigBasic, databaseClusterName, databaseDBName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + testAccCheckDataSourceDigitalOceanDatabaseDBsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_database_dbs.all", "dbs.*", map[string]string{
+						"name": "defaultdb",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_database_dbs.all", "dbs.*", map[string]string{
+						"name": databaseDBName,
+					}),
+					resource.TestCheckResourceAttr("data.digitalocean_database_dbs.filtered", "dbs.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_dbs.filtered", "dbs.0.name", databaseDBName),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseDBsConfig = `
+
+data "digitalocean_database_dbs" "all" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+
+  depends_on = [digitalocean_database_db.foobar_db]
+}
+
+data "digitalocean_database_dbs" "filtered" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key    = "name"
+    values = [digitalocean_database_db.foobar_db.name]
+  }
+}`
@@ -0,0 +1,32 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseEvents() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseEventSchema(),
+		ResultAttributeName: "events",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the database cluster to list the events of.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return events which occurred at or after this time, in RFC 3339 format.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDatabaseEvent,
+		GetRecords:    getDigitalOceanDatabaseEvents,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,82 @@
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseEvents_Basic(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatasourceDatabaseEventsConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_events.foobar", "events.0.id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_events.foobar", "events.0.event_type"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_events.foobar", "events.0.create_time"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_events.foobar", "events.0.cluster_name", databaseName),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatasourceDatabaseEventsConfigSince,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_events.foobar", "events.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDatabaseEvents_InvalidSince(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_database_events" "foobar" {
+  cluster_id = "a4ad1b73-b0ad-4cf5-98a2-2b1e0a9bc4b5"
+  since      = "yesterday"
+}`,
+				ExpectError: regexp.MustCompile(`expected "since" to be a valid RFC3339 date`),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatasourceDatabaseEventsConfigBasic = `
+
+data "digitalocean_database_events" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  sort {
+    key       = "create_time"
+    direction = "desc"
+  }
+}`
+
+const testAccCheckDigitalOceanDatasourceDatabaseEventsConfigSince = `
+
+data "digitalocean_database_events" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  since      = "2100-01-01T00:00:00Z"
+}`
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseKafkaTopics() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseKafkaTopicsSchema(),
+		ResultAttributeName: "topics",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the database cluster to list the Kafka topics of.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDatabaseKafkaTopic,
+		GetRecords:    getDigitalOceanDatabaseKafkaTopics,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
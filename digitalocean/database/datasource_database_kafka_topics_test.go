@@ -0,0 +1,50 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseKafkaTopics_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterKafka, name, "3.5")
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseKafkaTopicBasic, dbConfig, "topic-foobar")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseKafkaTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + testAccCheckDataSourceDigitalOceanDatabaseKafkaTopicsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_kafka_topics.foobar", "topics.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_kafka_topics.foobar", "topics.0.name", "topic-foobar"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_kafka_topics.foobar", "topics.0.state", "active"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_kafka_topics.foobar", "topics.0.partition_count", "3"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_kafka_topics.foobar", "topics.0.replication_factor", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseKafkaTopicsConfig = `
+
+data "digitalocean_database_kafka_topics" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key      = "name"
+    values   = ["topic-"]
+    match_by = "substring"
+  }
+
+  depends_on = [digitalocean_database_kafka_topic.foobar]
+}`
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseOpensearchIndexes() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseOpensearchIndexesSchema(),
+		ResultAttributeName: "indexes",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the OpenSearch cluster to list the indexes of.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDatabaseOpensearchIndex,
+		GetRecords:    getDigitalOceanDatabaseOpensearchIndexes,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
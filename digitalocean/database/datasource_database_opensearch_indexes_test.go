@@ -0,0 +1,89 @@
+package database_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseOpensearchIndexes_Basic(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterOpensearch, databaseName, "2")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCreateDigitalOceanDatabaseOpensearchIndexes("digitalocean_database_cluster.foobar", "logs-2024.01.01"),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDataSourceDigitalOceanDatabaseOpensearchIndexesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.foobar", "indexes.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.name", "logs-2024.01.01"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.status", "open"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.doc_count", "0"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.health"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.size"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.create_time"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreateDigitalOceanDatabaseOpensearchIndexes creates empty indexes on
+// an OpenSearch cluster, in the given order, using the admin credentials.
+func testAccCreateDigitalOceanDatabaseOpensearchIndexes(n string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		for _, name := range names {
+			url := fmt.Sprintf("https://%s:%s/%s", attrs["host"], attrs["port"], name)
+			req, err := http.NewRequest(http.MethodPut, url, strings.NewReader("{}"))
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.SetBasicAuth(attrs["user"], attrs["password"])
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("Error creating index %s: %s", name, err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("Error creating index %s: unexpected status %s", name, resp.Status)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseOpensearchIndexesConfig = `
+
+data "digitalocean_database_opensearch_indexes" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key      = "name"
+    values   = ["^logs-"]
+    match_by = "re"
+  }
+}`
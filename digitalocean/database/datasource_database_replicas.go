@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseReplicas() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseReplicasSchema(),
+		ResultAttributeName: "replicas",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the database cluster to list the replicas of.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDatabaseReplica,
+		GetRecords:    getDigitalOceanDatabaseReplicas,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,56 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseReplicas_Basic(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+	databaseReplicaName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName) +
+		fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + testAccCheckDataSourceDigitalOceanDatabaseReplicasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_replicas.foobar", "replicas.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.uuid",
+						"digitalocean_database_replica.read-01", "uuid"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_replicas.foobar", "replicas.0.name", databaseReplicaName),
+					resource.TestCheckResourceAttr("data.digitalocean_database_replicas.foobar", "replicas.0.region", "nyc3"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_replicas.foobar", "replicas.0.size", "db-s-1vcpu-2gb"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_replicas.foobar", "replicas.0.tags.#", "1"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_replicas.foobar", "replicas.0.host"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_replicas.foobar", "replicas.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseReplicasConfig = `
+
+data "digitalocean_database_replicas" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key    = "region"
+    values = ["nyc3"]
+  }
+
+  depends_on = [digitalocean_database_replica.read-01]
+}`
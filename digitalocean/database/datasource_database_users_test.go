@@ -0,0 +1,57 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseUsers_Basic(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigBasic, databaseClusterName, databaseUserName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + testAccCheckDataSourceDigitalOceanDatabaseUsersConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_users.all", "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_database_users.all", "users.*", map[string]string{
+						"name": "doadmin",
+						"role": "primary",
+					}),
+					resource.TestCheckResourceAttr("data.digitalocean_database_users.filtered", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_users.filtered", "users.0.name", databaseUserName),
+					resource.TestCheckResourceAttr("data.digitalocean_database_users.filtered", "users.0.role", "normal"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseUsersConfig = `
+
+data "digitalocean_database_users" "all" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  depends_on = [digitalocean_database_user.foobar_user]
+}
+
+data "digitalocean_database_users" "filtered" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key    = "name"
+    values = [digitalocean_database_user.foobar_user.name]
+  }
+}`
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseDBsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the database.",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseDBs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := extra["cluster_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allDBs []interface{}
+
+	for {
+		dbs, resp, err := client.Databases.ListDBs(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving databases: %s", err)
+		}
+
+		for _, db := range dbs {
+			allDBs = append(allDBs, db)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving databases: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allDBs, nil
+}
+
+func flattenDigitalOceanDatabaseDB(rawDB, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	db, ok := rawDB.(godo.DatabaseDB)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabaseDB")
+	}
+
+	return map[string]interface{}{
+		"name": db.Name,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseEventSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the event.",
+		},
+		"cluster_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the database cluster the event occurred on.",
+		},
+		"event_type": {
+			Type:        schema.TypeString,
+			Description: "The type of the event, such as a maintenance, failover or resize.",
+		},
+		"create_time": {
+			Type:        schema.TypeString,
+			Description: "The time the event occurred, in RFC 3339 format.",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseEvents(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := extra["cluster_id"].(string)
+
+	var since time.Time
+	if v, ok := extra["since"].(string); ok && v != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing since: %s", err)
+		}
+	}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allEvents []interface{}
+
+	for {
+		events, resp, err := client.Databases.ListDatabaseEvents(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database events: %s", err)
+		}
+
+		for _, event := range events {
+			if !since.IsZero() {
+				created, err := time.Parse(time.RFC3339, event.CreateTime)
+				if err == nil && created.Before(since) {
+					continue
+				}
+			}
+
+			allEvents = append(allEvents, event)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database events: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allEvents, nil
+}
+
+func flattenDigitalOceanDatabaseEvent(rawEvent, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	event, ok := rawEvent.(godo.DatabaseEvent)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabaseEvent")
+	}
+
+	return map[string]interface{}{
+		"id":           event.ID,
+		"cluster_name": event.ServiceName,
+		"event_type":   event.EventType,
+		"create_time":  event.CreateTime,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseKafkaTopicsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the Kafka topic.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of the Kafka topic.",
+		},
+		"partition_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of partitions of the Kafka topic.",
+		},
+		"replication_factor": {
+			Type:        schema.TypeInt,
+			Description: "The number of nodes each partition of the Kafka topic is replicated on.",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseKafkaTopics(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := extra["cluster_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allTopics []interface{}
+
+	for {
+		topics, resp, err := client.Databases.ListTopics(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Kafka topics: %s", err)
+		}
+
+		for _, topic := range topics {
+			allTopics = append(allTopics, topic)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Kafka topics: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allTopics, nil
+}
+
+func flattenDigitalOceanDatabaseKafkaTopic(rawTopic, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	topic, ok := rawTopic.(godo.DatabaseTopic)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabaseTopic")
+	}
+
+	flattenedTopic := map[string]interface{}{
+		"name":               topic.Name,
+		"state":              topic.State,
+		"partition_count":    len(topic.Partitions),
+		"replication_factor": 0,
+	}
+
+	if topic.ReplicationFactor != nil {
+		flattenedTopic["replication_factor"] = int(*topic.ReplicationFactor)
+	}
+
+	return flattenedTopic, nil
+}
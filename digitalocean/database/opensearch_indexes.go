@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseOpensearchIndexesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the index.",
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Description: "The size of the index, in bytes.",
+		},
+		"doc_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of documents in the index.",
+		},
+		"health": {
+			Type:        schema.TypeString,
+			Description: "The health of the index, one of green, yellow or red.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the index, either open or close.",
+		},
+		"create_time": {
+			Type:        schema.TypeString,
+			Description: "The time the index was created, in RFC 3339 format.",
+		},
+		"number_of_shards": {
+			Type:        schema.TypeInt,
+			Description: "The number of primary shards of the index.",
+		},
+		"number_of_replicas": {
+			Type:        schema.TypeInt,
+			Description: "The number of replicas of each primary shard of the index.",
+		},
+	}
+}
+
+func listDatabaseOpensearchIndexes(ctx context.Context, client *godo.Client, clusterID string) ([]godo.DatabaseIndex, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allIndexes []godo.DatabaseIndex
+
+	for {
+		indexes, resp, err := client.Databases.ListIndexes(ctx, clusterID, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		allIndexes = append(allIndexes, indexes...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allIndexes, nil
+}
+
+func getDigitalOceanDatabaseOpensearchIndexes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := extra["cluster_id"].(string)
+
+	indexes, err := listDatabaseOpensearchIndexes(context.Background(), client, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving OpenSearch indexes: %s", err)
+	}
+
+	var allIndexes []interface{}
+	for _, index := range indexes {
+		allIndexes = append(allIndexes, index)
+	}
+
+	return allIndexes, nil
+}
+
+func flattenDigitalOceanDatabaseOpensearchIndex(rawIndex, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	index, ok := rawIndex.(godo.DatabaseIndex)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabaseIndex")
+	}
+
+	return map[string]interface{}{
+		"name":               index.IndexName,
+		"size":               int(index.Size),
+		"doc_count":          int(index.Docs),
+		"health":             index.Health,
+		"status":             index.Status,
+		"create_time":        index.CreateTime,
+		"number_of_shards":   int(index.NumberofShards),
+		"number_of_replicas": int(index.NumberofReplicas),
+	}, nil
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseReplicasSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "The unique universal identifier of the database replica.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the database replica.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "The slug of the region the database replica is located in.",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Description: "The slug of the size of the database replica.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the database replica.",
+		},
+		"private_network_uuid": {
+			Type:        schema.TypeString,
+			Description: "The ID of the VPC the database replica is located in.",
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Description: "The hostname used to connect to the database replica.",
+		},
+		"private_host": {
+			Type:        schema.TypeString,
+			Description: "The private hostname used to connect to the database replica.",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "The port used to connect to the database replica.",
+		},
+		"storage_size_mib": {
+			Type:        schema.TypeString,
+			Description: "The amount of storage of the database replica, in MiB.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date and time the database replica was created.",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getDigitalOceanDatabaseReplicas(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := extra["cluster_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allReplicas []interface{}
+
+	for {
+		replicas, resp, err := client.Databases.ListReplicas(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database replicas: %s", err)
+		}
+
+		for _, replica := range replicas {
+			allReplicas = append(allReplicas, replica)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database replicas: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allReplicas, nil
+}
+
+func flattenDigitalOceanDatabaseReplica(rawReplica, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	replica, ok := rawReplica.(godo.DatabaseReplica)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabaseReplica")
+	}
+
+	flattenedReplica := map[string]interface{}{
+		"uuid":                 replica.ID,
+		"name":                 replica.Name,
+		"region":               replica.Region,
+		"size":                 replica.Size,
+		"status":               replica.Status,
+		"private_network_uuid": replica.PrivateNetworkUUID,
+		"host":                 "",
+		"private_host":         "",
+		"port":                 0,
+		"storage_size_mib":     strconv.FormatUint(replica.StorageSizeMib, 10),
+		"created_at":           replica.CreatedAt.UTC().Format(time.RFC3339),
+		"tags":                 tag.FlattenTags(replica.Tags),
+	}
+
+	if replica.Connection != nil {
+		flattenedReplica["host"] = replica.Connection.Host
+		flattenedReplica["port"] = replica.Connection.Port
+	}
+
+	if replica.PrivateConnection != nil {
+		flattenedReplica["private_host"] = replica.PrivateConnection.Host
+	}
+
+	return flattenedReplica, nil
+}
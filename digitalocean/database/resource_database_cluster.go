@@ -131,6 +131,19 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				},
 			},
 
+			"maintenance_update_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether an update is pending installation on the database cluster",
+			},
+
+			"maintenance_update_descriptions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The descriptions of the updates pending installation on the database cluster",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"eviction_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -551,6 +564,10 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 		}
 	}
 
+	if err := setDatabaseMaintenanceUpdates(database.MaintenanceWindow, d); err != nil {
+		return diag.Errorf("Error setting pending updates for database cluster: %s", err)
+	}
+
 	if _, ok := d.GetOk("eviction_policy"); ok {
 		policy, _, err := client.Databases.GetEvictionPolicy(context.Background(), d.Id())
 		if err != nil {
@@ -667,6 +684,16 @@ func flattenMaintWindowOpts(opts godo.DatabaseMaintenanceWindow) []map[string]in
 	return result
 }
 
+func setDatabaseMaintenanceUpdates(window *godo.DatabaseMaintenanceWindow, d *schema.ResourceData) error {
+	if window == nil {
+		d.Set("maintenance_update_pending", false)
+		return d.Set("maintenance_update_descriptions", []string{})
+	}
+
+	d.Set("maintenance_update_pending", window.Pending)
+	return d.Set("maintenance_update_descriptions", window.Description)
+}
+
 func setDatabaseConnectionInfo(database *godo.Database, d *schema.ResourceData) error {
 	if database.Connection != nil {
 		d.Set("host", database.Connection.Host)
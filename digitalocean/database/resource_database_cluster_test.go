@@ -50,6 +50,8 @@ func TestAccDigitalOceanDatabaseCluster_Basic(t *testing.T) {
 						"digitalocean_database_cluster.foobar", "private_uri"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_cluster.foobar", "urn"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_cluster.foobar", "maintenance_update_pending"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_cluster.foobar", "tags.#", "1"),
 					resource.TestCheckResourceAttrSet(
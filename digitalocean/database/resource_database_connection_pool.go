@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,6 +21,7 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanDatabaseConnectionPoolCreate,
 		ReadContext:   resourceDigitalOceanDatabaseConnectionPoolRead,
+		UpdateContext: resourceDigitalOceanDatabaseConnectionPoolUpdate,
 		DeleteContext: resourceDigitalOceanDatabaseConnectionPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceDigitalOceanDatabaseConnectionPoolImport,
@@ -41,14 +45,12 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 			"user": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
 			"mode": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"session",
 					"transaction",
@@ -58,14 +60,12 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 			"size": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
 			"db_name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
@@ -102,6 +102,16 @@ func ResourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// Removing the user switches the pool to the inbound user, which
+		// can only be set when the pool is created.
+		CustomizeDiff: customdiff.ForceNewIfChange("user", func(ctx context.Context, old, new, meta interface{}) bool {
+			return old.(string) != "" && new.(string) == ""
+		}),
 	}
 }
 
@@ -166,6 +176,57 @@ func resourceDigitalOceanDatabaseConnectionPoolRead(ctx context.Context, d *sche
 	return nil
 }
 
+func resourceDigitalOceanDatabaseConnectionPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID, poolName := splitConnectionPoolID(d.Id())
+
+	opts := &godo.DatabaseUpdatePoolRequest{
+		User:     d.Get("user").(string),
+		Mode:     d.Get("mode").(string),
+		Size:     d.Get("size").(int),
+		Database: d.Get("db_name").(string),
+	}
+
+	log.Printf("[DEBUG] DatabaseConnectionPool update configuration: %#v", opts)
+	_, err := client.Databases.UpdatePool(ctx, clusterID, poolName, opts)
+	if err != nil {
+		return diag.Errorf("Error updating DatabaseConnectionPool: %s", err)
+	}
+
+	if err := waitForDatabaseConnectionPoolUpdate(ctx, client, clusterID, poolName, opts, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("Error waiting for DatabaseConnectionPool (%s) to be updated: %s", d.Id(), err)
+	}
+
+	return resourceDigitalOceanDatabaseConnectionPoolRead(ctx, d, meta)
+}
+
+// waitForDatabaseConnectionPoolUpdate waits until the pool reports the
+// settings requested by an update.
+func waitForDatabaseConnectionPoolUpdate(ctx context.Context, client *godo.Client, clusterID, poolName string, opts *godo.DatabaseUpdatePoolRequest, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"updating"},
+		Target:  []string{"updated"},
+		Refresh: func() (interface{}, string, error) {
+			pool, _, err := client.Databases.GetPool(ctx, clusterID, poolName)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if pool.Mode != opts.Mode || pool.Size != opts.Size || pool.Database != opts.Database ||
+				(opts.User != "" && pool.User != opts.User) {
+				return pool, "updating", nil
+			}
+
+			return pool, "updated", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
 func setConnectionPoolInfo(pool *godo.DatabasePool, d *schema.ResourceData) error {
 	if pool.Connection != nil {
 		d.Set("host", pool.Connection.Host)
@@ -67,6 +67,46 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseConnectionPool_UpdateInPlace(t *testing.T) {
+	var databaseConnectionPool godo.DatabasePool
+	databaseName := acceptance.RandomTestName()
+	databaseConnectionPoolName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseConnectionPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigBasic, databaseName, databaseConnectionPoolName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseConnectionPoolExists("digitalocean_database_connection_pool.pool-01", &databaseConnectionPool),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "size", "10"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseConnectionPoolConfigResized, databaseName, databaseConnectionPoolName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseConnectionPoolExists("digitalocean_database_connection_pool.pool-01", &databaseConnectionPool),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "size", "20"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "mode", "session"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "user", "doadmin"),
+					func(s *terraform.State) error {
+						if databaseConnectionPool.Size != 20 || databaseConnectionPool.Mode != "session" {
+							return fmt.Errorf("Bad pool settings: size %d, mode %s", databaseConnectionPool.Size, databaseConnectionPool.Mode)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseConnectionPool_InboundUser(t *testing.T) {
 
 	var databaseConnectionPool godo.DatabasePool
@@ -226,6 +266,25 @@ resource "digitalocean_database_connection_pool" "pool-01" {
   db_name    = "defaultdb"
 }`
 
+const testAccCheckDigitalOceanDatabaseConnectionPoolConfigResized = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+
+resource "digitalocean_database_connection_pool" "pool-01" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "%s"
+  mode       = "session"
+  size       = 20
+  db_name    = "defaultdb"
+  user       = "doadmin"
+}`
+
 const testAccCheckDigitalOceanDatabaseConnectionPoolConfigBad = `
 resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
@@ -0,0 +1,169 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseMaintenanceUpdate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseMaintenanceUpdateCreate,
+		ReadContext:   resourceDigitalOceanDatabaseMaintenanceUpdateRead,
+		DeleteContext: resourceDigitalOceanDatabaseMaintenanceUpdateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the database cluster to install pending updates on",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary values that, when changed, cause pending updates to be installed again",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"installed_updates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The descriptions of the updates which were pending when the installation was started",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseMaintenanceUpdateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	database, _, err := client.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving database cluster: %s", err)
+	}
+
+	var installed []string
+	if database.MaintenanceWindow != nil {
+		installed = database.MaintenanceWindow.Description
+	}
+
+	log.Printf("[INFO] Installing pending updates on database cluster: %s", clusterID)
+	_, err = client.Databases.InstallUpdate(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error installing updates on database cluster: %s", err)
+	}
+
+	// Setting the ID before waiting marks the resource as tainted if the
+	// cluster does not return online, so the installation is retried.
+	d.SetId(id.PrefixedUniqueId(clusterID + "-"))
+	d.Set("installed_updates", installed)
+
+	if err := waitForDatabaseClusterMaintenance(ctx, client, database, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for database cluster (%s) to install updates: %s", clusterID, err)
+	}
+
+	return nil
+}
+
+// waitForDatabaseClusterMaintenance waits for a cluster to install updates and
+// come back online. The cluster is still reported online right after the
+// installation is requested, so it first waits for the maintenance to start
+// before waiting for the cluster to return online. When no updates were
+// pending there may be nothing to start, so only the online wait is done.
+func waitForDatabaseClusterMaintenance(ctx context.Context, client *godo.Client, before *godo.Database, timeout time.Duration) error {
+	clusterID := before.ID
+	start := time.Now()
+
+	startConf := &retry.StateChangeConf{
+		Pending: []string{"online"},
+		Target:  []string{"started"},
+		Refresh: func() (interface{}, string, error) {
+			database, _, err := client.Databases.Get(ctx, clusterID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+			}
+
+			if databaseClusterMaintenanceStarted(before, database) {
+				return database, "started", nil
+			}
+
+			return database, "online", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	if before.MaintenanceWindow != nil && before.MaintenanceWindow.Pending {
+		if _, err := startConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("maintenance did not start: %s", err)
+		}
+	}
+
+	onlineConf := &retry.StateChangeConf{
+		Pending: []string{"maintenance", "migrating", "resizing", "forking", "creating"},
+		Target:  []string{"online"},
+		Refresh: func() (interface{}, string, error) {
+			database, _, err := client.Databases.Get(ctx, clusterID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+			}
+
+			return database, database.Status, nil
+		},
+		Timeout:    timeout - time.Since(start),
+		MinTimeout: 15 * time.Second,
+	}
+
+	_, err := onlineConf.WaitForStateContext(ctx)
+	return err
+}
+
+// databaseClusterMaintenanceStarted reports whether the cluster has left the
+// online state or shows that the pending updates were picked up, either by a
+// version change or by the pending maintenance being cleared.
+func databaseClusterMaintenanceStarted(before, current *godo.Database) bool {
+	if current.Status != "online" {
+		return true
+	}
+
+	if current.VersionSlug != before.VersionSlug {
+		return true
+	}
+
+	if before.MaintenanceWindow == nil || current.MaintenanceWindow == nil {
+		return false
+	}
+
+	return before.MaintenanceWindow.Pending && !current.MaintenanceWindow.Pending
+}
+
+func resourceDigitalOceanDatabaseMaintenanceUpdateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Installing updates is a one-off action, so there is nothing to refresh.
+	return nil
+}
+
+func resourceDigitalOceanDatabaseMaintenanceUpdateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Installed updates can not be undone, removing the resource only
+	// removes it from the state.
+	d.SetId("")
+	return nil
+}
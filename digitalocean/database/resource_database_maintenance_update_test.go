@@ -0,0 +1,78 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDatabaseMaintenanceUpdate_Basic(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig + fmt.Sprintf(testAccCheckDigitalOceanDatabaseMaintenanceUpdateConfig, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_maintenance_update.foobar", "cluster_id",
+						"digitalocean_database_cluster.foobar", "id"),
+					resource.TestCheckResourceAttrSet("digitalocean_database_maintenance_update.foobar", "id"),
+					testAccCheckDigitalOceanDatabaseClusterOnline("digitalocean_database_cluster.foobar"),
+				),
+			},
+			{
+				Config: databaseConfig + fmt.Sprintf(testAccCheckDigitalOceanDatabaseMaintenanceUpdateConfig, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_maintenance_update.foobar", "triggers.run", "second"),
+					testAccCheckDigitalOceanDatabaseClusterOnline("digitalocean_database_cluster.foobar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDatabaseClusterOnline(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		database, _, err := client.Databases.Get(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if database.Status != "online" {
+			return fmt.Errorf("Database cluster is %s, expected online", database.Status)
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckDigitalOceanDatabaseMaintenanceUpdateConfig = `
+
+resource "digitalocean_database_maintenance_update" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  triggers = {
+    run = "%s"
+  }
+}`
@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"log"
+	"math/big"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	metricsCredentialsID             = "metrics-credentials"
+	metricsCredentialsPasswordLength = 32
+	metricsCredentialsPasswordChars  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+func ResourceDigitalOceanDatabaseMetricsCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseMetricsCredentialsCreate,
+		ReadContext:   resourceDigitalOceanDatabaseMetricsCredentialsRead,
+		UpdateContext: resourceDigitalOceanDatabaseMetricsCredentialsUpdate,
+		DeleteContext: resourceDigitalOceanDatabaseMetricsCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The username for accessing database metrics",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				Description:  "The password for accessing database metrics. A random password is generated if not set",
+				ValidateFunc: validation.StringLenBetween(8, 128),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary values that, when changed, cause a new password to be generated",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := updateDatabaseMetricsCredentials(ctx, client, d); err != nil {
+		return err
+	}
+
+	// The credentials are shared by every database cluster in the account.
+	d.SetId(metricsCredentialsID)
+
+	return resourceDigitalOceanDatabaseMetricsCredentialsRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChanges("username", "password") {
+		if err := updateDatabaseMetricsCredentials(ctx, client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceDigitalOceanDatabaseMetricsCredentialsRead(ctx, d, meta)
+}
+
+func updateDatabaseMetricsCredentials(ctx context.Context, client *godo.Client, d *schema.ResourceData) diag.Diagnostics {
+	current, _, err := client.Databases.GetMetricsCredentials(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving database metrics credentials: %s", err)
+	}
+
+	username := current.BasicAuthUsername
+	if v, ok := d.GetOk("username"); ok {
+		username = v.(string)
+	}
+
+	// Unless a password is configured one is generated, so that a change of
+	// triggers replaces the resource and rotates the password.
+	password := d.Get("password").(string)
+	if password == "" {
+		password, err = generateMetricsCredentialsPassword()
+		if err != nil {
+			return diag.Errorf("Error generating database metrics password: %s", err)
+		}
+	}
+
+	req := &godo.DatabaseUpdateMetricsCredentialsRequest{
+		Credentials: &godo.DatabaseMetricsCredentials{
+			BasicAuthUsername: username,
+			BasicAuthPassword: password,
+		},
+	}
+
+	log.Printf("[INFO] Updating database metrics credentials for user %s", username)
+	if _, err := client.Databases.UpdateMetricsCredentials(ctx, req); err != nil {
+		return diag.Errorf("Error updating database metrics credentials: %s", err)
+	}
+
+	return nil
+}
+
+func generateMetricsCredentialsPassword() (string, error) {
+	max := big.NewInt(int64(len(metricsCredentialsPasswordChars)))
+	password := make([]byte, metricsCredentialsPasswordLength)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		password[i] = metricsCredentialsPasswordChars[n.Int64()]
+	}
+
+	return string(password), nil
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	creds, _, err := client.Databases.GetMetricsCredentials(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving database metrics credentials: %s", err)
+	}
+
+	d.Set("username", creds.BasicAuthUsername)
+	d.Set("password", creds.BasicAuthPassword)
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The metrics credentials of an account can not be removed, removing
+	// the resource only removes it from the state and leaves the current
+	// credentials in place.
+	d.SetId("")
+	return nil
+}
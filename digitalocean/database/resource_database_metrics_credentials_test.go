@@ -0,0 +1,100 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The metrics credentials are shared by the whole account, so these tests are
+// not run in parallel.
+func TestAccDigitalOceanDatabaseMetricsCredentials_Basic(t *testing.T) {
+	password := acceptance.RandomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseMetricsCredentialsConfigPassword, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("digitalocean_database_metrics_credentials.foobar", "username"),
+					resource.TestCheckResourceAttr("digitalocean_database_metrics_credentials.foobar", "password", password),
+					resource.TestCheckResourceAttrPair("digitalocean_database_metrics_credentials.foobar", "password",
+						"data.digitalocean_database_metrics_credentials.foobar", "password"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_database_metrics_credentials.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDatabaseMetricsCredentials_Rotate(t *testing.T) {
+	var password string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseMetricsCredentialsConfigTriggers, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("digitalocean_database_metrics_credentials.foobar", "password"),
+					testAccCheckDigitalOceanDatabaseMetricsCredentialsPassword("digitalocean_database_metrics_credentials.foobar", &password, false),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseMetricsCredentialsConfigTriggers, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_metrics_credentials.foobar", "triggers.rotation", "2"),
+					testAccCheckDigitalOceanDatabaseMetricsCredentialsPassword("digitalocean_database_metrics_credentials.foobar", &password, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDigitalOceanDatabaseMetricsCredentialsPassword records the
+// password in state and, when rotated is set, checks it differs from the
+// previously recorded one.
+func testAccCheckDigitalOceanDatabaseMetricsCredentialsPassword(n string, password *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["password"]
+		if rotated && current == *password {
+			return fmt.Errorf("Expected database metrics password to be rotated")
+		}
+
+		*password = current
+		return nil
+	}
+}
+
+const (
+	testAccCheckDigitalOceanDatabaseMetricsCredentialsConfigPassword = `
+resource "digitalocean_database_metrics_credentials" "foobar" {
+  password = "%s"
+}
+
+data "digitalocean_database_metrics_credentials" "foobar" {
+  depends_on = [digitalocean_database_metrics_credentials.foobar]
+}`
+
+	testAccCheckDigitalOceanDatabaseMetricsCredentialsConfigTriggers = `
+resource "digitalocean_database_metrics_credentials" "foobar" {
+  triggers = {
+    rotation = "%s"
+  }
+}`
+)
@@ -0,0 +1,276 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseOpensearchIndexRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseOpensearchIndexRetentionCreate,
+		ReadContext:   resourceDigitalOceanDatabaseOpensearchIndexRetentionRead,
+		UpdateContext: resourceDigitalOceanDatabaseOpensearchIndexRetentionUpdate,
+		DeleteContext: resourceDigitalOceanDatabaseOpensearchIndexRetentionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the OpenSearch cluster",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"index_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A wildcard pattern matching the names of the indexes the retention applies to, e.g. logs-*",
+				ValidateFunc: validateOpensearchIndexPattern,
+			},
+
+			"max_age_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of days after which matching indexes are deleted",
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"max_age_days", "max_count"},
+			},
+
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of most recently created matching indexes to keep",
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"max_age_days", "max_count"},
+			},
+
+			"expired_indexes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the matching indexes which are past the retention limits and will be deleted on the next apply",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"deleted_indexes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the indexes deleted by the last apply",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: planDatabaseOpensearchIndexRetention,
+	}
+}
+
+// planDatabaseOpensearchIndexRetention computes the indexes an apply deletes
+// from the new arguments, so that every deletion is shown in the plan as
+// deleted_indexes. Indexes which expired since the last apply cause an
+// update, so that the retention is enforced on every apply.
+func planDatabaseOpensearchIndexRetention(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	changed := d.HasChanges("cluster_id", "index_pattern", "max_age_days", "max_count")
+	if d.Id() != "" && !changed && len(d.Get("expired_indexes").([]interface{})) == 0 {
+		return nil
+	}
+
+	for _, key := range []string{"cluster_id", "index_pattern", "max_age_days", "max_count"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("expired_indexes"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("deleted_indexes")
+		}
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	indexes, err := listDatabaseOpensearchIndexes(ctx, client, clusterID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenSearch indexes of database cluster (%s): %s", clusterID, err)
+	}
+
+	expired := selectExpiredOpensearchIndexes(indexes, d.Get("index_pattern").(string),
+		d.Get("max_age_days").(int), d.Get("max_count").(int), time.Now())
+
+	if err := d.SetNew("deleted_indexes", expired); err != nil {
+		return err
+	}
+	return d.SetNewComputed("expired_indexes")
+}
+
+func validateOpensearchIndexPattern(v interface{}, k string) (ws []string, es []error) {
+	pattern := v.(string)
+	if pattern == "" {
+		es = append(es, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		es = append(es, fmt.Errorf("%q is not a valid pattern: %s", k, err))
+	}
+
+	return
+}
+
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	d.SetId(id.PrefixedUniqueId(clusterID + "-"))
+
+	if err := enforceDatabaseOpensearchIndexRetention(ctx, client, d); err != nil {
+		return diag.Errorf("Error enforcing OpenSearch index retention: %s", err)
+	}
+
+	return resourceDigitalOceanDatabaseOpensearchIndexRetentionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := enforceDatabaseOpensearchIndexRetention(ctx, client, d); err != nil {
+		return diag.Errorf("Error enforcing OpenSearch index retention: %s", err)
+	}
+
+	return resourceDigitalOceanDatabaseOpensearchIndexRetentionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	_, resp, err := client.Databases.Get(ctx, clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving database cluster: %s", err)
+	}
+
+	indexes, err := listDatabaseOpensearchIndexes(ctx, client, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving OpenSearch indexes: %s", err)
+	}
+
+	expired := selectExpiredOpensearchIndexes(indexes, d.Get("index_pattern").(string),
+		d.Get("max_age_days").(int), d.Get("max_count").(int), time.Now())
+	d.Set("expired_indexes", expired)
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing the retention leaves the remaining indexes in place.
+	d.SetId("")
+	return nil
+}
+
+func enforceDatabaseOpensearchIndexRetention(ctx context.Context, client *godo.Client, d *schema.ResourceData) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	indexes, err := listDatabaseOpensearchIndexes(ctx, client, clusterID)
+	if err != nil {
+		return err
+	}
+
+	expired := selectExpiredOpensearchIndexes(indexes, d.Get("index_pattern").(string),
+		d.Get("max_age_days").(int), d.Get("max_count").(int), time.Now())
+
+	// Only the indexes shown in the plan are deleted, indexes which expired
+	// since are left for the next apply.
+	if d.GetRawPlan().GetAttr("deleted_indexes").IsKnown() {
+		planned := make(map[string]bool)
+		for _, name := range d.Get("deleted_indexes").([]interface{}) {
+			planned[name.(string)] = true
+		}
+
+		filtered := make([]string, 0, len(expired))
+		for _, name := range expired {
+			if planned[name] {
+				filtered = append(filtered, name)
+			}
+		}
+		expired = filtered
+	}
+
+	deleted := make([]string, 0, len(expired))
+	for _, name := range expired {
+		log.Printf("[INFO] Deleting OpenSearch index %s from database cluster %s", name, clusterID)
+		resp, err := client.Databases.DeleteIndex(ctx, clusterID, name)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			d.Set("deleted_indexes", deleted)
+			return fmt.Errorf("Error deleting index %s: %s", name, err)
+		}
+		deleted = append(deleted, name)
+	}
+
+	d.Set("deleted_indexes", deleted)
+
+	return nil
+}
+
+// selectExpiredOpensearchIndexes returns the names of the indexes matching
+// pattern which are older than maxAgeDays, or are not among the maxCount most
+// recently created. A zero limit is not applied. Hidden indexes, whose names
+// start with a dot, are only matched by patterns which also start with one.
+func selectExpiredOpensearchIndexes(indexes []godo.DatabaseIndex, pattern string, maxAgeDays, maxCount int, now time.Time) []string {
+	type matchedIndex struct {
+		name    string
+		created time.Time
+	}
+
+	var matched []matchedIndex
+	for _, index := range indexes {
+		if strings.HasPrefix(index.IndexName, ".") && !strings.HasPrefix(pattern, ".") {
+			continue
+		}
+
+		if ok, _ := path.Match(pattern, index.IndexName); !ok {
+			continue
+		}
+
+		created, _ := time.Parse(time.RFC3339, index.CreateTime)
+		matched = append(matched, matchedIndex{name: index.IndexName, created: created})
+	}
+
+	// Newest first, falling back to the name for indexes created at the
+	// same time, such as daily indexes named by date.
+	sort.SliceStable(matched, func(i, j int) bool {
+		if !matched[i].created.Equal(matched[j].created) {
+			return matched[i].created.After(matched[j].created)
+		}
+		return matched[i].name > matched[j].name
+	})
+
+	expired := make([]string, 0)
+	for i, index := range matched {
+		tooOld := maxAgeDays > 0 && !index.created.IsZero() &&
+			now.Sub(index.created) > time.Duration(maxAgeDays)*24*time.Hour
+		tooMany := maxCount > 0 && i >= maxCount
+
+		if tooOld || tooMany {
+			expired = append(expired, index.name)
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,119 @@
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanDatabaseOpensearchIndexRetention_MaxCount(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterOpensearch, databaseName, "2")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCreateDigitalOceanDatabaseOpensearchIndexes("digitalocean_database_cluster.foobar",
+						"logs-2024.01.01", "logs-2024.01.02", "logs-2024.01.03", "metrics-2024.01.01"),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "deleted_indexes.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "deleted_indexes.0", "logs-2024.01.02"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "deleted_indexes.1", "logs-2024.01.01"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "expired_indexes.#", "0"),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfig +
+					testAccCheckDataSourceDigitalOceanDatabaseOpensearchIndexesRemaining,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.remaining", "indexes.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.remaining", "indexes.0.name", "logs-2024.01.03"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_opensearch_indexes.remaining", "indexes.1.name", "metrics-2024.01.01"),
+				),
+			},
+			{
+				// Widening the pattern plans the deletion of the newly
+				// matched indexes.
+				Config: databaseConfig + testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfigWidened,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "deleted_indexes.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "deleted_indexes.0", "logs-2024.01.03"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "expired_indexes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDatabaseOpensearchIndexRetention_InvalidArguments(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_database_opensearch_index_retention" "foobar" {
+  cluster_id    = "a4ad1b73-b0ad-4cf5-98a2-2b1e0a9bc4b5"
+  index_pattern = "logs-*"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`one of .max_age_days,max_count. must be specified`),
+			},
+			{
+				Config: `
+resource "digitalocean_database_opensearch_index_retention" "foobar" {
+  cluster_id    = "a4ad1b73-b0ad-4cf5-98a2-2b1e0a9bc4b5"
+  index_pattern = "logs-["
+  max_count     = 7
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"index_pattern" is not a valid pattern`),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfig = `
+
+resource "digitalocean_database_opensearch_index_retention" "foobar" {
+  cluster_id    = digitalocean_database_cluster.foobar.id
+  index_pattern = "logs-*"
+  max_count     = 1
+}`
+
+const testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfigWidened = `
+
+resource "digitalocean_database_opensearch_index_retention" "foobar" {
+  cluster_id    = digitalocean_database_cluster.foobar.id
+  index_pattern = "*-2024.01.*"
+  max_count     = 1
+}`
+
+const testAccCheckDataSourceDigitalOceanDatabaseOpensearchIndexesRemaining = `
+
+data "digitalocean_database_opensearch_indexes" "remaining" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key      = "name"
+    values   = ["^(logs|metrics)-"]
+    match_by = "re"
+  }
+
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+}`
@@ -326,8 +326,14 @@ func resourceDigitalOceanDatabaseReplicaDelete(ctx context.Context, d *schema.Re
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting DatabaseReplica: %s", d.Id())
-	_, err := client.Databases.DeleteReplica(context.Background(), clusterId, name)
+	resp, err := client.Databases.DeleteReplica(context.Background(), clusterId, name)
 	if err != nil {
+		// The replica no longer exists once it has been promoted to primary.
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
 		return diag.Errorf("Error deleting DatabaseReplica: %s", err)
 	}
 
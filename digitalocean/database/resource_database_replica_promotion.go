@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseReplicaPromotionCreate,
+		ReadContext:   resourceDigitalOceanDatabaseReplicaPromotionRead,
+		DeleteContext: resourceDigitalOceanDatabaseReplicaPromotionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the primary database cluster of the replica",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"replica_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the read-only replica to promote",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"promoted_cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the database cluster the replica was promoted to",
+			},
+
+			"promoted_cluster_urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name of the database cluster the replica was promoted to",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseReplicaPromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("replica_name").(string)
+
+	replica, _, err := client.Databases.GetReplica(ctx, clusterID, name)
+	if err != nil {
+		return diag.Errorf("Error retrieving DatabaseReplica: %s", err)
+	}
+
+	log.Printf("[INFO] Promoting DatabaseReplica %s to primary", makeReplicaId(clusterID, name))
+	_, err = client.Databases.PromoteReplicaToPrimary(ctx, clusterID, name)
+	if err != nil {
+		return diag.Errorf("Error promoting DatabaseReplica: %s", err)
+	}
+
+	// The promoted replica keeps its ID as an independent cluster. Setting
+	// the ID before waiting marks the resource as tainted if promotion fails.
+	d.SetId(replica.ID)
+
+	database, err := waitForDatabaseReplicaPromotion(ctx, client, clusterID, name, replica.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Error promoting DatabaseReplica: %s", err)
+	}
+
+	d.Set("promoted_cluster_id", database.ID)
+	d.Set("promoted_cluster_urn", database.URN())
+
+	return nil
+}
+
+// waitForDatabaseReplicaPromotion waits for a replica to be promoted. The
+// replica is usually already online when the promotion is requested, so it
+// first waits for the replica to leave its primary cluster before waiting for
+// the promoted cluster to be online.
+func waitForDatabaseReplicaPromotion(ctx context.Context, client *godo.Client, clusterID, name, replicaID string, timeout time.Duration) (*godo.Database, error) {
+	start := time.Now()
+
+	detachConf := &retry.StateChangeConf{
+		Pending: []string{"replica"},
+		Target:  []string{"detached"},
+		Refresh: func() (interface{}, string, error) {
+			replica, resp, err := client.Databases.GetReplica(ctx, clusterID, name)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return struct{}{}, "detached", nil
+				}
+
+				return nil, "", fmt.Errorf("Error trying to read DatabaseReplica state: %s", err)
+			}
+
+			return replica, "replica", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	if _, err := detachConf.WaitForStateContext(ctx); err != nil {
+		return nil, fmt.Errorf("replica was not detached from its primary cluster: %s", err)
+	}
+
+	onlineConf := &retry.StateChangeConf{
+		Pending: []string{"creating", "migrating", "resizing", "forking", "maintenance"},
+		Target:  []string{"online"},
+		Refresh: func() (interface{}, string, error) {
+			database, resp, err := client.Databases.Get(ctx, replicaID)
+			if err != nil {
+				// The promoted cluster may not be listed yet right after
+				// leaving the primary.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return struct{}{}, "creating", nil
+				}
+
+				return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+			}
+
+			return database, database.Status, nil
+		},
+		Timeout:    timeout - time.Since(start),
+		MinTimeout: 15 * time.Second,
+	}
+
+	database, err := onlineConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.(*godo.Database), nil
+}
+
+func resourceDigitalOceanDatabaseReplicaPromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	// Promotion can not be repeated, so the resource is kept in the state
+	// even once the promoted cluster has been destroyed.
+	database, resp, err := client.Databases.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving database cluster: %s", err)
+	}
+
+	d.Set("promoted_cluster_id", database.ID)
+	d.Set("promoted_cluster_urn", database.URN())
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseReplicaPromotionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Promotion can not be undone, removing the resource only removes it
+	// from the state. The promoted cluster is left running.
+	d.SetId("")
+	return nil
+}
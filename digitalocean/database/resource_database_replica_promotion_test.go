@@ -0,0 +1,147 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDatabaseReplicaPromotion_Basic(t *testing.T) {
+	var databaseReplica godo.DatabaseReplica
+	var database godo.Database
+
+	databaseName := acceptance.RandomTestName()
+	databaseReplicaName := acceptance.RandomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+	promotionConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaPromotionConfigBasic, databaseReplicaName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseReplicaPromotionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig + replicaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseReplicaExists("digitalocean_database_replica.read-01", &databaseReplica),
+				),
+			},
+			{
+				// Once promoted, the replica no longer exists and is planned
+				// to be created again.
+				Config: databaseConfig + replicaConfig + promotionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_replica_promotion.foobar", "promoted_cluster_id",
+						"digitalocean_database_replica.read-01", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_replica_promotion.foobar", "promoted_cluster_urn"),
+					testAccCheckDigitalOceanDatabaseReplicaPromoted("digitalocean_database_replica_promotion.foobar"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: databaseConfig + fmt.Sprintf(`
+resource "digitalocean_database_replica_promotion" "foobar" {
+  cluster_id   = digitalocean_database_cluster.foobar.id
+  replica_name = "%s"
+}`, databaseReplicaName),
+			},
+			{
+				Config: databaseConfig + fmt.Sprintf(`
+resource "digitalocean_database_replica_promotion" "foobar" {
+  cluster_id   = digitalocean_database_cluster.foobar.id
+  replica_name = "%s"
+}
+
+resource "digitalocean_database_cluster" "promoted" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-2gb"
+  region     = "nyc3"
+  node_count = 1
+}`, databaseReplicaName, databaseReplicaName),
+				ResourceName:      "digitalocean_database_cluster.promoted",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDigitalOceanDatabaseReplicaPromotedClusterID("digitalocean_database_replica_promotion.foobar"),
+			},
+		},
+	})
+}
+
+func testAccDigitalOceanDatabaseReplicaPromotedClusterID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["promoted_cluster_id"], nil
+	}
+}
+
+func testAccCheckDigitalOceanDatabaseReplicaPromoted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		database, _, err := client.Databases.Get(context.Background(), rs.Primary.Attributes["promoted_cluster_id"])
+		if err != nil {
+			return fmt.Errorf("Error retrieving promoted database cluster: %s", err)
+		}
+
+		if database.Status != "online" {
+			return fmt.Errorf("Promoted database cluster is %s, expected online", database.Status)
+		}
+
+		_, _, err = client.Databases.GetReplica(context.Background(), rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["replica_name"])
+		if err == nil {
+			return fmt.Errorf("DatabaseReplica still exists after promotion")
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDigitalOceanDatabaseReplicaPromotionDestroy removes the promoted
+// cluster, which destroying the promotion leaves running, and checks that the
+// original cluster was destroyed.
+func testAccCheckDigitalOceanDatabaseReplicaPromotionDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_database_replica_promotion" {
+			continue
+		}
+
+		resp, err := client.Databases.Delete(context.Background(), rs.Primary.ID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("Error deleting promoted database cluster: %s", err)
+		}
+	}
+
+	return testAccCheckDigitalOceanDatabaseClusterDestroy(s)
+}
+
+const testAccCheckDigitalOceanDatabaseReplicaPromotionConfigBasic = `
+
+resource "digitalocean_database_replica_promotion" "foobar" {
+  cluster_id   = digitalocean_database_cluster.foobar.id
+  replica_name = "%s"
+
+  depends_on = [digitalocean_database_replica.read-01]
+}`
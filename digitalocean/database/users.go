@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseUsersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the database user.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Description: "The role of the database user, either primary or normal.",
+		},
+		"mysql_auth_plugin": {
+			Type:        schema.TypeString,
+			Description: "The authentication method of a MySQL database user.",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseUsers(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := extra["cluster_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allUsers []interface{}
+
+	for {
+		users, resp, err := client.Databases.ListUsers(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database users: %s", err)
+		}
+
+		for _, user := range users {
+			allUsers = append(allUsers, user)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database users: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allUsers, nil
+}
+
+func flattenDigitalOceanDatabaseUser(rawUser, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	user, ok := rawUser.(godo.DatabaseUser)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DatabaseUser")
+	}
+
+	flattenedUser := map[string]interface{}{
+		"name":              user.Name,
+		"role":              user.Role,
+		"mysql_auth_plugin": "",
+	}
+
+	if user.MySQLSettings != nil {
+		flattenedUser["mysql_auth_plugin"] = user.MySQLSettings.AuthPlugin
+	}
+
+	return flattenedUser, nil
+}
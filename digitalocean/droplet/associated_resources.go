@@ -0,0 +1,201 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The selective and dangerous destroy endpoints, and the endpoint reporting
+// their progress, are not wrapped by godo, so they are requested directly.
+const dropletAssociatedResourcesPath = "v2/droplets/%d/destroy_with_associated_resources"
+
+// dropletDeleteSelectiveRequest lists the IDs of the associated resources to
+// destroy along with a Droplet.
+type dropletDeleteSelectiveRequest struct {
+	Snapshots       []string `json:"snapshots"`
+	Volumes         []string `json:"volumes"`
+	VolumeSnapshots []string `json:"volume_snapshots"`
+}
+
+// dropletDestroyStatus is the progress of destroying a Droplet along with its
+// associated resources.
+type dropletDestroyStatus struct {
+	Droplet     *dropletDestroyedResource              `json:"droplet"`
+	Resources   map[string][]*dropletDestroyedResource `json:"resources"`
+	CompletedAt *time.Time                             `json:"completed_at"`
+	Failures    int                                    `json:"failures"`
+}
+
+type dropletDestroyedResource struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	DestroyedAt  *time.Time `json:"destroyed_at"`
+	ErrorMessage string     `json:"error_message"`
+}
+
+func dropletAssociatedResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The associated resources to destroy along with the Droplet",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"all": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to destroy all of the Droplet's associated resources, including reserved IPs",
+					ConflictsWith: []string{
+						"destroy_associated_resources.0.snapshot_ids",
+						"destroy_associated_resources.0.volume_ids",
+						"destroy_associated_resources.0.volume_snapshot_ids",
+					},
+				},
+				"snapshot_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the Droplet snapshots to destroy",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"volume_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the attached volumes to destroy",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"volume_snapshot_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the volume snapshots to destroy",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// expandDropletDeleteSelectiveRequest builds the request for a selective
+// destroy from the destroy_associated_resources block. Only the resources the
+// API reports as associated with the Droplet are included; any other IDs are
+// skipped with a warning.
+func expandDropletDeleteSelectiveRequest(raw map[string]interface{}, associated *godo.DropletAssociatedResources) *dropletDeleteSelectiveRequest {
+	return &dropletDeleteSelectiveRequest{
+		Snapshots:       filterDropletAssociatedResources("snapshot", raw["snapshot_ids"].(*schema.Set), associated.Snapshots),
+		Volumes:         filterDropletAssociatedResources("volume", raw["volume_ids"].(*schema.Set), associated.Volumes),
+		VolumeSnapshots: filterDropletAssociatedResources("volume snapshot", raw["volume_snapshot_ids"].(*schema.Set), associated.VolumeSnapshots),
+	}
+}
+
+func filterDropletAssociatedResources(kind string, requested *schema.Set, associated []*godo.DropletAssociatedResource) []string {
+	available := make(map[string]bool, len(associated))
+	for _, resource := range associated {
+		available[resource.ID] = true
+	}
+
+	ids := []string{}
+	for _, id := range requested.List() {
+		if !available[id.(string)] {
+			log.Printf("[WARN] %s (%s) is not associated with the droplet, skipping", kind, id)
+			continue
+		}
+		ids = append(ids, id.(string))
+	}
+
+	return ids
+}
+
+func deleteDropletSelective(ctx context.Context, client *godo.Client, dropletID int, request *dropletDeleteSelectiveRequest) (*godo.Response, error) {
+	path := fmt.Sprintf(dropletAssociatedResourcesPath+"/selective", dropletID)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+func deleteDropletDangerous(ctx context.Context, client *godo.Client, dropletID int) (*godo.Response, error) {
+	path := fmt.Sprintf(dropletAssociatedResourcesPath+"/dangerous", dropletID)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Dangerous", "true")
+
+	return client.Do(ctx, req, nil)
+}
+
+func getDropletDestroyStatus(ctx context.Context, client *godo.Client, dropletID int) (*dropletDestroyStatus, *godo.Response, error) {
+	path := fmt.Sprintf(dropletAssociatedResourcesPath+"/status", dropletID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(dropletDestroyStatus)
+	resp, err := client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// waitForDropletDestroyStatus waits for the destroy of a Droplet and its
+// associated resources to complete, returning an error listing every resource
+// that could not be destroyed.
+func waitForDropletDestroyStatus(ctx context.Context, client *godo.Client, dropletID int, timeout time.Duration) error {
+	log.Printf("[INFO] Waiting for droplet (%d) and its associated resources to be destroyed", dropletID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"destroying"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := getDropletDestroyStatus(ctx, client, dropletID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error retrieving destroy status: %s", err)
+			}
+
+			if status.CompletedAt == nil {
+				return status, "destroying", nil
+			}
+
+			return status, "completed", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := result.(*dropletDestroyStatus)
+	if status.Failures == 0 {
+		return nil
+	}
+
+	var failures []string
+	if status.Droplet != nil && status.Droplet.ErrorMessage != "" {
+		failures = append(failures, fmt.Sprintf("droplet (%s): %s", status.Droplet.ID, status.Droplet.ErrorMessage))
+	}
+	for kind, resources := range status.Resources {
+		for _, resource := range resources {
+			if resource.ErrorMessage != "" {
+				failures = append(failures, fmt.Sprintf("%s (%s): %s", kind, resource.ID, resource.ErrorMessage))
+			}
+		}
+	}
+
+	return fmt.Errorf("%d associated resources could not be destroyed:\n%s", status.Failures, strings.Join(failures, "\n"))
+}
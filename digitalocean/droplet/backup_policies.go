@@ -0,0 +1,201 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dropletDefaultBackupPlan is the plan used when backups are enabled without
+// a backup_policy block.
+const dropletDefaultBackupPlan = "daily"
+
+func dropletBackupPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the backup plan",
+		},
+		"possible_window_starts": {
+			Type:        schema.TypeList,
+			Description: "The hours of the day at which a backup window can start",
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+		},
+		"window_length_hours": {
+			Type:        schema.TypeInt,
+			Description: "The length of the backup window in hours",
+		},
+		"retention_period_days": {
+			Type:        schema.TypeInt,
+			Description: "The number of days backups are kept for",
+		},
+		"possible_days": {
+			Type:        schema.TypeList,
+			Description: "The days of the week on which a backup can be taken",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func getDigitalOceanDropletBackupPolicies(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	policies, _, err := client.Droplets.ListSupportedBackupPolicies(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving supported droplet backup policies: %s", err)
+	}
+
+	var allPolicies []interface{}
+	for _, policy := range policies {
+		allPolicies = append(allPolicies, *policy)
+	}
+
+	return allPolicies, nil
+}
+
+func flattenDigitalOceanDropletBackupPolicy(rawPolicy, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	policy, ok := rawPolicy.(godo.SupportedBackupPolicy)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.SupportedBackupPolicy")
+	}
+
+	windowStarts := make([]interface{}, len(policy.PossibleWindowStarts))
+	for i, start := range policy.PossibleWindowStarts {
+		windowStarts[i] = start
+	}
+
+	days := make([]interface{}, len(policy.PossibleDays))
+	for i, day := range policy.PossibleDays {
+		days[i] = day
+	}
+
+	return map[string]interface{}{
+		"name":                   policy.Name,
+		"possible_window_starts": windowStarts,
+		"window_length_hours":    policy.WindowLengthHours,
+		"retention_period_days":  policy.RetentionPeriodDays,
+		"possible_days":          days,
+	}, nil
+}
+
+// dropletBackupPolicyConfigured reports whether a backup_policy block is set
+// in the configuration. As the block is also computed, its planned value holds
+// the live policy when the block is omitted.
+func dropletBackupPolicyConfigured(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	policy := config.GetAttr("backup_policy")
+	return policy.IsKnown() && !policy.IsNull() && policy.LengthInt() > 0
+}
+
+// planDropletBackupPolicyReset plans an update when the backup_policy block is
+// removed from the configuration of a Droplet whose live policy is not the
+// default one, so that the removal resets the policy rather than being
+// silently ignored.
+func planDropletBackupPolicyReset(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("backups").(bool) || d.HasChange("backups") {
+		return nil
+	}
+
+	if dropletBackupPolicyConfigured(d.GetRawConfig()) {
+		return nil
+	}
+
+	policies := d.Get("backup_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil || d.Get("backup_policy.0.plan").(string) == dropletDefaultBackupPlan {
+		return nil
+	}
+
+	return d.SetNewComputed("backup_policy")
+}
+
+// validateDropletBackupPolicy checks the backup_policy block against the
+// backup policies supported by the API, so that an unsupported plan, weekday
+// or hour fails during plan rather than apply.
+func validateDropletBackupPolicy(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("backup_policy") {
+		return nil
+	}
+
+	policies := d.Get("backup_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	if !d.NewValueKnown("backup_policy.0.plan") {
+		return nil
+	}
+
+	plan := d.Get("backup_policy.0.plan").(string)
+	if plan == "" {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	supported, _, err := client.Droplets.ListSupportedBackupPolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("Error retrieving supported droplet backup policies: %s", err)
+	}
+
+	return checkDropletBackupPolicy(supported, plan,
+		d.Get("backup_policy.0.weekday").(string), d.NewValueKnown("backup_policy.0.weekday"),
+		d.Get("backup_policy.0.hour").(int), d.NewValueKnown("backup_policy.0.hour"))
+}
+
+func checkDropletBackupPolicy(supported []*godo.SupportedBackupPolicy, plan string, weekday string, weekdayKnown bool, hour int, hourKnown bool) error {
+	var policy *godo.SupportedBackupPolicy
+	names := make([]string, 0, len(supported))
+	for _, p := range supported {
+		names = append(names, p.Name)
+		if p.Name == plan {
+			policy = p
+		}
+	}
+
+	if policy == nil {
+		return fmt.Errorf("backup_policy plan %q is not supported, must be one of: %s", plan, strings.Join(names, ", "))
+	}
+
+	if weekdayKnown && weekday != "" && len(policy.PossibleDays) > 0 && !slices.Contains(policy.PossibleDays, weekday) {
+		return fmt.Errorf("backup_policy weekday %q is not supported for the %s plan, must be one of: %s",
+			weekday, plan, strings.Join(policy.PossibleDays, ", "))
+	}
+
+	if hourKnown && len(policy.PossibleWindowStarts) > 0 && !slices.Contains(policy.PossibleWindowStarts, hour) {
+		starts := make([]string, len(policy.PossibleWindowStarts))
+		for i, start := range policy.PossibleWindowStarts {
+			starts[i] = fmt.Sprintf("%d", start)
+		}
+		return fmt.Errorf("backup_policy hour %d is not supported for the %s plan, must be one of: %s",
+			hour, plan, strings.Join(starts, ", "))
+	}
+
+	return nil
+}
+
+// flattenDropletBackupPolicyConfig flattens the live backup policy of a
+// Droplet into the backup_policy block.
+func flattenDropletBackupPolicyConfig(policy *godo.DropletBackupPolicyConfig) []interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"plan":                  policy.Plan,
+			"weekday":               policy.Weekday,
+			"hour":                  policy.Hour,
+			"window_length_hours":   policy.WindowLengthHours,
+			"retention_period_days": policy.RetentionPeriodDays,
+		},
+	}
+}
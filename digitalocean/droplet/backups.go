@@ -0,0 +1,111 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dropletBackupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the backup image",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the backup image",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date and time when the backup was created",
+		},
+		"size": {
+			Type:        schema.TypeFloat,
+			Description: "The size of the backup image in gigabytes",
+		},
+		"min_disk_size": {
+			Type:        schema.TypeInt,
+			Description: "The minimum disk size in gigabytes required by a Droplet created from the backup",
+		},
+		"regions": {
+			Type:        schema.TypeSet,
+			Description: "The regions where the backup image is available",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"distribution": {
+			Type:        schema.TypeString,
+			Description: "The distribution of the OS of the backup image",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the backup image",
+		},
+	}
+}
+
+func getDigitalOceanDropletBackups(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID, ok := extra["droplet_id"].(int)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `droplet_id` key from query data")
+	}
+
+	var allBackups []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		backups, resp, err := client.Droplets.Backups(context.Background(), dropletID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for droplet (%d): %s", dropletID, err)
+		}
+
+		for _, backup := range backups {
+			allBackups = append(allBackups, backup)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for droplet (%d): %s", dropletID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allBackups, nil
+}
+
+func flattenDigitalOceanDropletBackup(rawBackup, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	backup, ok := rawBackup.(godo.Image)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.Image")
+	}
+
+	flattenedRegions := schema.NewSet(schema.HashString, []interface{}{})
+	for _, region := range backup.Regions {
+		flattenedRegions.Add(region)
+	}
+
+	return map[string]interface{}{
+		"id":            backup.ID,
+		"name":          backup.Name,
+		"created_at":    backup.Created,
+		"size":          backup.SizeGigaBytes,
+		"min_disk_size": backup.MinDiskSize,
+		"regions":       flattenedRegions,
+		"distribution":  backup.Distribution,
+		"status":        backup.Status,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package droplet
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanDropletBackupPolicies() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletBackupPolicySchema(),
+		ResultAttributeName: "policies",
+		FlattenRecord:       flattenDigitalOceanDropletBackupPolicy,
+		GetRecords:          getDigitalOceanDropletBackupPolicies,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,34 @@
+package droplet_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletBackupPolicies_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_droplet_backup_policies" "weekly" {
+  filter {
+    key    = "name"
+    values = ["weekly"]
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.weekly", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.weekly", "policies.0.name", "weekly"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backup_policies.weekly", "policies.0.retention_period_days"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backup_policies.weekly", "policies.0.window_length_hours"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backup_policies.weekly", "policies.0.possible_window_starts.#"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backup_policies.weekly", "policies.0.possible_days.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package droplet
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletBackups() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletBackupSchema(),
+		ResultAttributeName: "backups",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDropletBackup,
+		GetRecords:    getDigitalOceanDropletBackups,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,135 @@
+package droplet_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testDropletWithBackupsEnvVar holds the ID of a Droplet that already has at
+// least one backup. Backups are taken on a schedule, so they can not be created
+// as part of a test run. The Droplet is expected to use the default size and to
+// be located outside of syd1.
+const testDropletWithBackupsEnvVar = "DO_TEST_DROPLET_WITH_BACKUPS"
+
+func TestAccDataSourceDigitalOceanDropletBackups_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name    = "%s"
+  size    = "%s"
+  image   = "%s"
+  region  = "nyc3"
+  backups = true
+}
+`, name, defaultSize, defaultImage)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig + `
+data "digitalocean_droplet_backups" "result" {
+  droplet_id = digitalocean_droplet.foo.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					// A new Droplet has not been backed up yet.
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backups.result", "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDropletBackups_RestoreLatest(t *testing.T) {
+	dropletID := os.Getenv(testDropletWithBackupsEnvVar)
+	if dropletID == "" {
+		t.Skipf("Test requires a Droplet with backups. Set %s", testDropletWithBackupsEnvVar)
+	}
+	name := acceptance.RandomTestName()
+
+	backupsConfig := fmt.Sprintf(`
+data "digitalocean_droplet_backups" "latest" {
+  droplet_id = %s
+
+  sort {
+    key       = "created_at"
+    direction = "desc"
+  }
+}
+`, dropletID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: backupsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backups.latest", "backups.0.id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backups.latest", "backups.0.name"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backups.latest", "backups.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backups.latest", "backups.0.min_disk_size"),
+				),
+			},
+			{
+				// The disk of the smallest size is too small for a backup
+				// of the default size.
+				Config: backupsConfig + fmt.Sprintf(`
+resource "digitalocean_droplet" "restored" {
+  name   = "%s"
+  size   = "s-1vcpu-512mb-10gb"
+  image  = data.digitalocean_droplet_backups.latest.backups[0].id
+  region = tolist(data.digitalocean_droplet_backups.latest.backups[0].regions)[0]
+}`, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`requires a disk of at least \d+ GB`),
+			},
+			{
+				Config: backupsConfig + fmt.Sprintf(`
+resource "digitalocean_droplet" "restored" {
+  name   = "%s"
+  size   = "%s"
+  image  = data.digitalocean_droplet_backups.latest.backups[0].id
+  region = "syd1"
+}`, name, defaultSize),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`backup image \(\d+\) is not available in region syd1`),
+			},
+			{
+				Config: backupsConfig + fmt.Sprintf(`
+resource "digitalocean_droplet" "restored" {
+  name   = "%s"
+  size   = "%s"
+  image  = data.digitalocean_droplet_backups.latest.backups[0].id
+  region = tolist(data.digitalocean_droplet_backups.latest.backups[0].regions)[0]
+}`, name, defaultSize),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_droplet.restored", "status", "active"),
+					resource.TestCheckResourceAttrPair("digitalocean_droplet.restored", "image",
+						"data.digitalocean_droplet_backups.latest", "backups.0.id"),
+				),
+			},
+			{
+				// Changing the region replaces the Droplet, so the image is
+				// checked against the new region.
+				Config: backupsConfig + fmt.Sprintf(`
+resource "digitalocean_droplet" "restored" {
+  name   = "%s"
+  size   = "%s"
+  image  = data.digitalocean_droplet_backups.latest.backups[0].id
+  region = "syd1"
+}`, name, defaultSize),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`backup image \(\d+\) is not available in region syd1`),
+			},
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package droplet
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletKernels() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletKernelSchema(),
+		ResultAttributeName: "kernels",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanDropletKernel,
+		GetRecords:    getDigitalOceanDropletKernels,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,39 @@
+package droplet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletKernels_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+}
+`, name, defaultSize, defaultImage)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig + `
+data "digitalocean_droplet_kernels" "result" {
+  droplet_id = digitalocean_droplet.foo.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_kernels.result", "kernels.#"),
+				),
+			},
+		},
+	})
+}
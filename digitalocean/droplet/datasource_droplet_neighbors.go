@@ -0,0 +1,131 @@
+package droplet
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDropletNeighborsRead,
+		Schema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The ID of the Droplet whose neighbors are listed. If omitted, all groups of neighbors in the account are listed",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"neighbors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Droplets running on the same physical host as the Droplet",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"urn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tag.TagsDataSourceSchema(),
+					},
+				},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The groups of Droplets in the account which share a physical host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"droplet_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDropletNeighborsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if v, ok := d.GetOk("droplet_id"); ok {
+		dropletID := v.(int)
+
+		neighbors, _, err := client.Droplets.Neighbors(context.Background(), dropletID)
+		if err != nil {
+			return diag.Errorf("Error retrieving neighbors of droplet (%d): %s", dropletID, err)
+		}
+
+		flattenedNeighbors := make([]interface{}, 0, len(neighbors))
+		for _, neighbor := range neighbors {
+			region := ""
+			if neighbor.Region != nil {
+				region = neighbor.Region.Slug
+			}
+
+			flattenedNeighbors = append(flattenedNeighbors, map[string]interface{}{
+				"id":     neighbor.ID,
+				"name":   neighbor.Name,
+				"urn":    neighbor.URN(),
+				"region": region,
+				"status": neighbor.Status,
+				"tags":   tag.FlattenTags(neighbor.Tags),
+			})
+		}
+
+		d.SetId(strconv.Itoa(dropletID))
+		if err := d.Set("neighbors", flattenedNeighbors); err != nil {
+			return diag.Errorf("Error setting `neighbors`: %+v", err)
+		}
+
+		return nil
+	}
+
+	groups, err := getDropletNeighborIDs(context.Background(), client)
+	if err != nil {
+		return diag.Errorf("Error retrieving droplet neighbors: %s", err)
+	}
+
+	flattenedGroups := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		ids := make([]interface{}, len(group))
+		for i, id := range group {
+			ids[i] = id
+		}
+
+		flattenedGroups = append(flattenedGroups, map[string]interface{}{
+			"droplet_ids": ids,
+		})
+	}
+
+	d.SetId("droplet-neighbors")
+	if err := d.Set("groups", flattenedGroups); err != nil {
+		return diag.Errorf("Error setting `groups`: %+v", err)
+	}
+
+	return nil
+}
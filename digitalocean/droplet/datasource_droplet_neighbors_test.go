@@ -0,0 +1,61 @@
+package droplet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletNeighbors_Droplet(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+}
+`, name, defaultSize, defaultImage)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig + `
+data "digitalocean_droplet_neighbors" "result" {
+  droplet_id = digitalocean_droplet.foo.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_droplet_neighbors.result", "droplet_id", "digitalocean_droplet.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_neighbors.result", "neighbors.#"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_neighbors.result", "groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDropletNeighbors_Account(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_droplet_neighbors" "result" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_neighbors.result", "id", "droplet-neighbors"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_neighbors.result", "groups.#"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_neighbors.result", "neighbors.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,80 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dropletKernelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the kernel",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the kernel",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "The version of the kernel",
+		},
+	}
+}
+
+func getDigitalOceanDropletKernels(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID, ok := extra["droplet_id"].(int)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `droplet_id` key from query data")
+	}
+
+	var allKernels []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		kernels, resp, err := client.Droplets.Kernels(context.Background(), dropletID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving kernels for droplet (%d): %s", dropletID, err)
+		}
+
+		for _, kernel := range kernels {
+			allKernels = append(allKernels, kernel)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving kernels for droplet (%d): %s", dropletID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allKernels, nil
+}
+
+func flattenDigitalOceanDropletKernel(rawKernel, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	kernel, ok := rawKernel.(godo.Kernel)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.Kernel")
+	}
+
+	return map[string]interface{}{
+		"id":      kernel.ID,
+		"name":    kernel.Name,
+		"version": kernel.Version,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// The account-wide neighbors report is not wrapped by godo, so it is
+// requested directly.
+const dropletNeighborsReportPath = "v2/reports/droplet_neighbors_ids"
+
+type dropletNeighborsReport struct {
+	NeighborIDs [][]int `json:"neighbor_ids"`
+}
+
+// getDropletNeighborIDs returns groups of IDs of Droplets in the account which
+// share a physical host.
+func getDropletNeighborIDs(ctx context.Context, client *godo.Client) ([][]int, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, dropletNeighborsReportPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report := new(dropletNeighborsReport)
+	if _, err := client.Do(ctx, req, report); err != nil {
+		return nil, err
+	}
+
+	return report.NeighborIDs, nil
+}
+
+// checkDropletAntiAffinity returns a warning if the Droplet shares a physical
+// host with other Droplets carrying the anti_affinity_tag.
+func checkDropletAntiAffinity(ctx context.Context, meta interface{}, dropletID int, tag string) diag.Diagnostics {
+	if tag == "" {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	neighbors, _, err := client.Droplets.Neighbors(ctx, dropletID)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to check droplet anti-affinity",
+			Detail:   fmt.Sprintf("Error retrieving neighbors of droplet (%d): %s", dropletID, err),
+		}}
+	}
+
+	var tagged []string
+	for _, neighbor := range neighbors {
+		if neighbor.ID != dropletID && slices.Contains(neighbor.Tags, tag) {
+			tagged = append(tagged, fmt.Sprintf("%s (%d)", neighbor.Name, neighbor.ID))
+		}
+	}
+
+	if len(tagged) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Droplet shares a physical host with droplets tagged " + tag,
+		Detail: fmt.Sprintf("Droplet (%d) is running on the same physical host as: %s. "+
+			"A failure of the host would affect all of them.", dropletID, strings.Join(tagged, ", ")),
+	}}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"slices"
 	"strconv"
 	"strings"
@@ -90,6 +91,13 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Default:  false,
 			},
 
+			"anti_affinity_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A tag identifying Droplets which should not share a physical host with this Droplet",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,6 +158,7 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 			"backup_policy": {
 				Type:         schema.TypeList,
 				Optional:     true,
+				Computed:     true,
 				MaxItems:     1,
 				RequiredWith: []string{"backups"},
 				Elem: &schema.Resource{
@@ -157,6 +166,7 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 						"plan": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"daily",
 								"weekly",
@@ -165,6 +175,7 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 						"weekday": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT",
 							}, false),
@@ -172,8 +183,17 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 						"hour": {
 							Type:         schema.TypeInt,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.IntBetween(0, 20),
 						},
+						"window_length_hours": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"retention_period_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -251,6 +271,8 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 
 			"tags": tag.TagsSchema(),
 
+			"destroy_associated_resources": dropletAssociatedResourcesSchema(),
+
 			"vpc_uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -281,10 +303,99 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 					return old.(bool) && !new.(bool)
 				},
 			),
+			validateDropletImageFits,
+			validateDropletBackupPolicy,
+			planDropletBackupPolicyReset,
 		),
 	}
 }
 
+// validateDropletImageFits checks that a backup or snapshot used as the image
+// of a new Droplet is available in the Droplet's region and fits on the disk
+// of the Droplet's size. This includes Droplets replaced because their image
+// or region changed. Other images are left for the API to validate.
+func validateDropletImageFits(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("image", "region") {
+		return nil
+	}
+
+	if !d.NewValueKnown("image") || !d.NewValueKnown("region") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	imageID, err := strconv.Atoi(d.Get("image").(string))
+	if err != nil {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	image, _, err := client.Images.GetByID(ctx, imageID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving image (%d): %s", imageID, err)
+	}
+
+	if image.Type != "backup" && image.Type != "snapshot" {
+		return nil
+	}
+
+	region := strings.ToLower(d.Get("region").(string))
+	if region != "" && !slices.Contains(image.Regions, region) {
+		return fmt.Errorf("%s image (%d) is not available in region %s, it is available in: %s",
+			image.Type, imageID, region, strings.Join(image.Regions, ", "))
+	}
+
+	if image.MinDiskSize > 0 {
+		sizeSlug := strings.ToLower(d.Get("size").(string))
+		size, err := findSizeBySlug(ctx, client, sizeSlug)
+		if err != nil {
+			return err
+		}
+
+		if size != nil && size.Disk < image.MinDiskSize {
+			return fmt.Errorf("%s image (%d) requires a disk of at least %d GB, but size %s has a %d GB disk",
+				image.Type, imageID, image.MinDiskSize, sizeSlug, size.Disk)
+		}
+	}
+
+	return nil
+}
+
+// findSizeBySlug returns the Droplet size with the given slug, or nil if
+// there is no such size.
+func findSizeBySlug(ctx context.Context, client *godo.Client, slug string) (*godo.Size, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		sizes, resp, err := client.Sizes.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		for i := range sizes {
+			if sizes[i].Slug == slug {
+				return &sizes[i], nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return nil, nil
+}
+
 func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -308,7 +419,7 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if attr, ok := d.GetOk("backups"); ok {
-		_, exist := d.GetOk("backup_policy")
+		exist := dropletBackupPolicyConfigured(d.GetRawConfig())
 		if exist && !attr.(bool) { // Check there is no backup_policy specified when backups are disabled.
 			return diag.FromErr(errDropletBackupPolicy)
 		}
@@ -365,7 +476,8 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Get configured backup_policy
-	if policy, ok := d.GetOk("backup_policy"); ok {
+	if dropletBackupPolicyConfigured(d.GetRawConfig()) {
+		policy := d.Get("backup_policy")
 		if !d.Get("backups").(bool) {
 			return diag.FromErr(errDropletBackupPolicy)
 		}
@@ -396,7 +508,7 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 
 	// waitForDropletAttribute updates the Droplet's state and calls setDropletAttributes.
 	// So there is no need to call resourceDigitalOceanDropletRead and add additional API calls.
-	return nil
+	return checkDropletAntiAffinity(ctx, meta, droplet.ID, d.Get("anti_affinity_tag").(string))
 }
 
 func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -425,7 +537,23 @@ func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	return nil
+	// The live backup policy is always read back when backups are enabled,
+	// so that imported Droplets and changes made outside of Terraform are
+	// reflected in the state.
+	if slices.Contains(droplet.Features, "backups") {
+		policy, _, err := client.Droplets.GetBackupPolicy(ctx, id)
+		if err != nil {
+			return diag.Errorf("Error retrieving backup policy for droplet (%s): %s", d.Id(), err)
+		}
+
+		if err := d.Set("backup_policy", flattenDropletBackupPolicyConfig(policy.BackupPolicy)); err != nil {
+			return diag.Errorf("Error setting `backup_policy`: %+v", err)
+		}
+	} else {
+		d.Set("backup_policy", nil)
+	}
+
+	return checkDropletAntiAffinity(ctx, meta, id, d.Get("anti_affinity_tag").(string))
 }
 
 func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet) error {
@@ -613,8 +741,8 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 			// Enable backups on droplet
 			var action *godo.Action
 			// Apply backup_policy if specified, otherwise use the default policy
-			policy, ok := d.GetOk("backup_policy")
-			if ok {
+			if dropletBackupPolicyConfigured(d.GetRawConfig()) {
+				policy := d.Get("backup_policy")
 				backupPolicy, err := expandBackupPolicy(policy)
 				if err != nil {
 					return diag.FromErr(err)
@@ -637,8 +765,7 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		} else {
 			// Disable backups on droplet
 			// Check there is no backup_policy specified
-			_, ok := d.GetOk("backup_policy")
-			if ok {
+			if dropletBackupPolicyConfigured(d.GetRawConfig()) {
 				return diag.FromErr(errDropletBackupPolicy)
 			}
 			action, _, err := client.DropletActions.DisableBackups(context.Background(), id)
@@ -654,8 +781,7 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.HasChange("backup_policy") {
-		_, ok := d.GetOk("backup_policy")
-		if ok {
+		if dropletBackupPolicyConfigured(d.GetRawConfig()) {
 			if !d.Get("backups").(bool) {
 				return diag.FromErr(errDropletBackupPolicy)
 			}
@@ -675,6 +801,18 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 			if err := util.WaitForAction(client, action); err != nil {
 				return diag.Errorf("error waiting for backup policy to be changed for droplet (%s): %s", d.Id(), err)
 			}
+		} else if d.Get("backups").(bool) && !d.HasChange("backups") {
+			// The backup_policy block was removed, reset the default policy.
+			policy := &godo.DropletBackupPolicyRequest{Plan: dropletDefaultBackupPlan}
+			action, _, err := client.DropletActions.ChangeBackupPolicy(context.Background(), id, policy)
+			if err != nil {
+				return diag.Errorf(
+					"error resetting backup policy on droplet (%s): %s", d.Id(), err)
+			}
+
+			if err := util.WaitForAction(client, action); err != nil {
+				return diag.Errorf("error waiting for backup policy to be reset for droplet (%s): %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -812,6 +950,10 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if raw, ok := d.GetOk("destroy_associated_resources"); ok {
+		return deleteDropletWithAssociatedResources(ctx, d, meta, raw.([]interface{}))
+	}
+
 	log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from droplet: %s", d.Id())
 	err = detachVolumesFromDroplet(d, meta)
 	if err != nil {
@@ -839,6 +981,79 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// deleteDropletWithAssociatedResources destroys the Droplet along with the
+// associated resources selected in the destroy_associated_resources block.
+// Attached volumes which are not selected are detached first so that they
+// survive the Droplet.
+func deleteDropletWithAssociatedResources(ctx context.Context, d *schema.ResourceData, meta interface{}, raw []interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid droplet id: %v", err)
+	}
+
+	selected := map[string]interface{}{
+		"all":                 false,
+		"snapshot_ids":        schema.NewSet(schema.HashString, nil),
+		"volume_ids":          schema.NewSet(schema.HashString, nil),
+		"volume_snapshot_ids": schema.NewSet(schema.HashString, nil),
+	}
+	if len(raw) > 0 && raw[0] != nil {
+		selected = raw[0].(map[string]interface{})
+	}
+	all := selected["all"].(bool)
+
+	if !all {
+		destroyed := selected["volume_ids"].(*schema.Set)
+		for _, volumeID := range d.Get("volume_ids").(*schema.Set).List() {
+			if destroyed.Contains(volumeID) {
+				continue
+			}
+			if err := detachVolumeIDOnDroplet(d, volumeID.(string), meta); err != nil {
+				return diag.Errorf("Error detaching the volumes from the droplet (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	associated, resp, err := client.Droplets.ListAssociatedResourcesForDeletion(ctx, id)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("Error retrieving resources associated with droplet (%s): %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Resources associated with droplet (%s): %s", d.Id(), associated)
+
+	if all {
+		log.Printf("[INFO] Deleting droplet (%s) and all of its associated resources", d.Id())
+		resp, err = deleteDropletDangerous(ctx, client, id)
+	} else {
+		request := expandDropletDeleteSelectiveRequest(selected, associated)
+		log.Printf("[INFO] Deleting droplet (%s) and associated resources: %#v", d.Id(), request)
+		resp, err = deleteDropletSelective(ctx, client, id, request)
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("Error deleting droplet (%s) with associated resources: %s", d.Id(), err)
+	}
+
+	// Destroying snapshots and volumes takes longer than destroying a
+	// Droplet on its own, so wait for at least ten minutes.
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if timeout < 10*time.Minute {
+		timeout = 10 * time.Minute
+	}
+
+	if err := waitForDropletDestroyStatus(ctx, client, id, timeout); err != nil {
+		return diag.Errorf("Error deleting droplet (%s) with associated resources: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 func waitForDropletDestroy(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("[INFO] Waiting for droplet (%s) to be destroyed", d.Id())
 
@@ -1041,7 +1256,12 @@ func expandBackupPolicy(v interface{}) (*godo.DropletBackupPolicyRequest, error)
 		if !ok {
 			return nil, errors.New("backup_policy weekday is not a string")
 		}
-		policy.Weekday = weekday
+		// The weekday only applies to weekly plans. As it is computed, it can
+		// be left over in state from a previous weekly plan, so it is not sent
+		// for daily plans.
+		if plan != "daily" {
+			policy.Weekday = weekday
+		}
 
 		hourVal, exists := policyMap["hour"]
 		if !exists {
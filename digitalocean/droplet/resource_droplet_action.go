@@ -0,0 +1,228 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	dropletActionPowerCycle    = "power_cycle"
+	dropletActionReboot        = "reboot"
+	dropletActionPasswordReset = "password_reset"
+	dropletActionRebuild       = "rebuild"
+	dropletActionRestore       = "restore"
+	dropletActionChangeKernel  = "change_kernel"
+)
+
+func ResourceDigitalOceanDropletAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDropletActionCreate,
+		ReadContext:   resourceDigitalOceanDropletActionRead,
+		DeleteContext: resourceDigitalOceanDropletActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the Droplet to run the action against",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of action to run",
+				ValidateFunc: validation.StringInSlice([]string{
+					dropletActionPowerCycle,
+					dropletActionReboot,
+					dropletActionPasswordReset,
+					dropletActionRebuild,
+					dropletActionRestore,
+					dropletActionChangeKernel,
+				}, false),
+			},
+			"image": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The image slug or ID to rebuild the Droplet from, or the ID of the backup or snapshot to restore",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"kernel_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The ID of the kernel to switch the Droplet to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary values that, when changed, cause the action to be run again",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"action_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the action",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the action",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the action was started",
+			},
+			"completed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the action was completed",
+			},
+		},
+
+		CustomizeDiff: validateDropletActionArguments,
+	}
+}
+
+func validateDropletActionArguments(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	actionType := d.Get("type").(string)
+
+	// Values which are unknown during plan, e.g. because they reference
+	// another resource, are treated as set.
+	image, hasImage := d.GetOk("image")
+	hasImage = hasImage || !d.NewValueKnown("image")
+	_, hasKernel := d.GetOk("kernel_id")
+	hasKernel = hasKernel || !d.NewValueKnown("kernel_id")
+
+	switch actionType {
+	case dropletActionRebuild:
+		if !hasImage {
+			return fmt.Errorf("image is required for %s actions", actionType)
+		}
+	case dropletActionRestore:
+		if !hasImage {
+			return fmt.Errorf("image is required for %s actions", actionType)
+		}
+		if d.NewValueKnown("image") {
+			if _, err := strconv.Atoi(image.(string)); err != nil {
+				return fmt.Errorf("image must be the numeric ID of a backup or snapshot for %s actions", actionType)
+			}
+		}
+	case dropletActionChangeKernel:
+		if !hasKernel {
+			return fmt.Errorf("kernel_id is required for %s actions", actionType)
+		}
+	}
+
+	if hasImage && actionType != dropletActionRebuild && actionType != dropletActionRestore {
+		return fmt.Errorf("image can only be set for %s and %s actions", dropletActionRebuild, dropletActionRestore)
+	}
+	if hasKernel && actionType != dropletActionChangeKernel {
+		return fmt.Errorf("kernel_id can only be set for %s actions", dropletActionChangeKernel)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID := d.Get("droplet_id").(int)
+	actionType := d.Get("type").(string)
+
+	log.Printf("[INFO] Running %s action on droplet (%d)", actionType, dropletID)
+
+	var action *godo.Action
+	var err error
+	switch actionType {
+	case dropletActionPowerCycle:
+		action, _, err = client.DropletActions.PowerCycle(context.Background(), dropletID)
+	case dropletActionReboot:
+		action, _, err = client.DropletActions.Reboot(context.Background(), dropletID)
+	case dropletActionPasswordReset:
+		action, _, err = client.DropletActions.PasswordReset(context.Background(), dropletID)
+	case dropletActionRebuild:
+		image := d.Get("image").(string)
+		if imageID, convErr := strconv.Atoi(image); convErr == nil {
+			action, _, err = client.DropletActions.RebuildByImageID(context.Background(), dropletID, imageID)
+		} else {
+			action, _, err = client.DropletActions.RebuildByImageSlug(context.Background(), dropletID, image)
+		}
+	case dropletActionRestore:
+		imageID, convErr := strconv.Atoi(d.Get("image").(string))
+		if convErr != nil {
+			return diag.Errorf("invalid image id for restore: %v", convErr)
+		}
+		action, _, err = client.DropletActions.Restore(context.Background(), dropletID, imageID)
+	case dropletActionChangeKernel:
+		action, _, err = client.DropletActions.ChangeKernel(context.Background(), dropletID, d.Get("kernel_id").(int))
+	default:
+		return diag.Errorf("unsupported droplet action type: %s", actionType)
+	}
+	if err != nil {
+		return diag.Errorf("Error running %s action on droplet (%d): %s", actionType, dropletID, err)
+	}
+
+	d.SetId(strconv.Itoa(action.ID))
+	log.Printf("[INFO] Droplet action ID: %s", d.Id())
+
+	if err := util.WaitForAction(client, action); err != nil {
+		return diag.Errorf("Error waiting for %s action on droplet (%d) to finish: %s", actionType, dropletID, err)
+	}
+
+	return resourceDigitalOceanDropletActionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDropletActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid droplet action id: %v", err)
+	}
+
+	action, resp, err := client.Actions.Get(context.Background(), id)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Droplet action (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving droplet action: %s", err)
+	}
+
+	d.Set("action_id", action.ID)
+	d.Set("status", action.Status)
+	if action.StartedAt != nil {
+		d.Set("started_at", action.StartedAt.UTC().Format(time.RFC3339))
+	}
+	if action.CompletedAt != nil {
+		d.Set("completed_at", action.CompletedAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Actions cannot be undone, so there is nothing to do other than
+	// removing the resource from state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,161 @@
+package droplet_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDropletAction_Reboot(t *testing.T) {
+	var droplet godo.Droplet
+	var firstAction, secondAction godo.Action
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletActionConfig_reboot(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					testAccCheckDigitalOceanDropletActionExists("digitalocean_droplet_action.reboot", &firstAction),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.reboot", "type", "reboot"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.reboot", "status", "completed"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_droplet_action.reboot", "droplet_id", "digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_action.reboot", "action_id"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_action.reboot", "completed_at"),
+				),
+			},
+			{
+				// Changing the triggers runs the action again.
+				Config: testAccCheckDigitalOceanDropletActionConfig_reboot(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletActionExists("digitalocean_droplet_action.reboot", &secondAction),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.reboot", "status", "completed"),
+					func(s *terraform.State) error {
+						if firstAction.ID == secondAction.ID {
+							return fmt.Errorf("expected a new action to be run, got the same action ID %d", firstAction.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletAction_Rebuild(t *testing.T) {
+	var action godo.Action
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name) + `
+resource "digitalocean_droplet_action" "rebuild" {
+  droplet_id = digitalocean_droplet.foobar.id
+  type       = "rebuild"
+  image      = "ubuntu-24-04-x64"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletActionExists("digitalocean_droplet_action.rebuild", &action),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.rebuild", "status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletAction_InvalidArguments(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_droplet_action" "foobar" {
+  droplet_id = 12345
+  type       = "rebuild"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("image is required for rebuild actions"),
+			},
+			{
+				Config: `
+resource "digitalocean_droplet_action" "foobar" {
+  droplet_id = 12345
+  type       = "restore"
+  image      = "ubuntu-22-04-x64"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("image must be the numeric ID of a backup or snapshot"),
+			},
+			{
+				Config: `
+resource "digitalocean_droplet_action" "foobar" {
+  droplet_id = 12345
+  type       = "reboot"
+  kernel_id  = 12345
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("kernel_id can only be set for change_kernel actions"),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDropletActionExists(n string, action *godo.Action) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Droplet action ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		foundAction, _, err := client.Actions.Get(context.Background(), id)
+		if err != nil {
+			return err
+		}
+
+		*action = *foundAction
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanDropletActionConfig_reboot(name string, trigger string) string {
+	return acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name) + fmt.Sprintf(`
+
+resource "digitalocean_droplet_action" "reboot" {
+  droplet_id = digitalocean_droplet.foobar.id
+  type       = "reboot"
+
+  triggers = {
+    run = "%s"
+  }
+}`, trigger)
+}
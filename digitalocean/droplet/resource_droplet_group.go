@@ -0,0 +1,693 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// dropletGroupBatchSize is the maximum number of Droplets that can be
+	// created with a single request.
+	dropletGroupBatchSize = 10
+
+	// dropletGroupConcurrency is the maximum number of Droplets polled or
+	// deleted at the same time.
+	dropletGroupConcurrency = 10
+)
+
+func ResourceDigitalOceanDropletGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDropletGroupCreate,
+		ReadContext:   resourceDigitalOceanDropletGroupRead,
+		UpdateContext: resourceDigitalOceanDropletGroupUpdate,
+		DeleteContext: resourceDigitalOceanDropletGroupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "The names of the Droplets in the group",
+				ExactlyOneOf: []string{"names", "name_prefix"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The prefix of the names of the Droplets in the group, which are named `<name_prefix>-<n>`",
+				ExactlyOneOf: []string{"names", "name_prefix"},
+				RequiredWith: []string{"droplet_count"},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"droplet_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Description:   "The number of Droplets in the group when using name_prefix",
+				ConflictsWith: []string{"names"},
+				RequiredWith:  []string{"name_prefix"},
+				ValidateFunc:  validation.IntAtLeast(0),
+			},
+			"image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The slug or ID of the image the Droplets are created from",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The size slug of the Droplets",
+				StateFunc: func(val interface{}) string {
+					// DO API V2 size slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The region where the Droplets are created",
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ssh_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IDs or fingerprints of the SSH keys added to the Droplets",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"vpc_uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The ID of the VPC the Droplets are created in",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ipv6": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether IPv6 is enabled on the Droplets",
+			},
+			"monitoring": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the monitoring agent is installed on the Droplets",
+			},
+			"user_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "The user data provided to the Droplets",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"tags": tag.TagsSchema(),
+			"droplets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Droplets in the group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the Droplet",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the Droplet",
+						},
+						"urn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uniform resource name of the Droplet",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the Droplet",
+						},
+						"ipv4_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public IPv4 address of the Droplet",
+						},
+						"ipv4_address_private": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The private IPv4 address of the Droplet",
+						},
+						"ipv6_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public IPv6 address of the Droplet",
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.All(
+			validateDropletGroupNames,
+			customdiff.ComputedIf("droplets", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("names", "droplet_count", "tags")
+			}),
+		),
+	}
+}
+
+func validateDropletGroupNames(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("names") {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range d.Get("names").([]interface{}) {
+		n, _ := name.(string)
+		if seen[n] {
+			return fmt.Errorf("names must be unique, %q is listed more than once", n)
+		}
+		seen[n] = true
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(id.UniqueId())
+	log.Printf("[INFO] Droplet group ID: %s", d.Id())
+
+	return resourceDigitalOceanDropletGroupApply(ctx, d, meta, schema.TimeoutCreate)
+}
+
+func resourceDigitalOceanDropletGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChange("tags") {
+		if err := setDropletGroupTags(ctx, client, d); err != nil {
+			return diag.Errorf("Error updating tags of droplet group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanDropletGroupApply(ctx, d, meta, schema.TimeoutUpdate)
+}
+
+// resourceDigitalOceanDropletGroupApply creates the Droplets that are missing
+// from the group and deletes the ones that are no longer wanted, leaving the
+// remaining Droplets untouched.
+func resourceDigitalOceanDropletGroupApply(ctx context.Context, d *schema.ResourceData, meta interface{}, timeoutKey string) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	wanted := expandDropletGroupNames(d)
+	wantedSet := make(map[string]bool, len(wanted))
+	for _, name := range wanted {
+		wantedSet[name] = true
+	}
+
+	existing := make(map[string]bool)
+	var keep, toDelete []int
+	for _, raw := range priorDropletGroupDroplets(d) {
+		droplet := raw.(map[string]interface{})
+		name := droplet["name"].(string)
+		if wantedSet[name] && !existing[name] {
+			existing[name] = true
+			keep = append(keep, droplet["id"].(int))
+		} else {
+			toDelete = append(toDelete, droplet["id"].(int))
+		}
+	}
+
+	var toCreate []string
+	for _, name := range wanted {
+		if !existing[name] {
+			toCreate = append(toCreate, name)
+		}
+	}
+
+	var errs []string
+
+	if len(toDelete) > 0 {
+		log.Printf("[INFO] Deleting %d droplets from droplet group (%s)", len(toDelete), d.Id())
+		for i, err := range deleteDropletGroupDroplets(ctx, client, toDelete, d.Timeout(timeoutKey)) {
+			if err != nil {
+				// Keep the Droplet in state so that deleting it is retried.
+				keep = append(keep, toDelete[i])
+				errs = append(errs, fmt.Sprintf("deleting droplet (%d): %s", toDelete[i], err))
+			}
+		}
+	}
+
+	if len(toCreate) > 0 {
+		log.Printf("[INFO] Creating %d droplets in droplet group (%s)", len(toCreate), d.Id())
+		created, err := createDropletGroupDroplets(ctx, client, d, toCreate)
+		for _, droplet := range created {
+			keep = append(keep, droplet.ID)
+		}
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	droplets, waitErrs := waitForDropletGroupDroplets(ctx, client, keep, d.Timeout(timeoutKey))
+	errs = append(errs, waitErrs...)
+
+	if err := setDropletGroupDroplets(d, droplets, wanted); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(errs) > 0 {
+		return diag.Errorf("Error applying droplet group (%s):\n%s", d.Id(), strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	ids := make(map[int]bool)
+	for _, raw := range d.Get("droplets").([]interface{}) {
+		ids[raw.(map[string]interface{})["id"].(int)] = true
+	}
+
+	// Listing all Droplets rather than getting each one keeps the number of
+	// requests low for large groups.
+	var droplets []godo.Droplet
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		page, resp, err := client.Droplets.List(ctx, opts)
+		if err != nil {
+			return diag.Errorf("Error retrieving droplets: %s", err)
+		}
+
+		for _, droplet := range page {
+			if ids[droplet.ID] {
+				droplets = append(droplets, droplet)
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		currentPage, err := resp.Links.CurrentPage()
+		if err != nil {
+			return diag.Errorf("Error retrieving droplets: %s", err)
+		}
+
+		opts.Page = currentPage + 1
+	}
+
+	if len(ids) > 0 && len(droplets) == 0 {
+		log.Printf("[WARN] None of the droplets in droplet group (%s) were found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := setDropletGroupDroplets(d, droplets, expandDropletGroupNames(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(droplets) > 0 {
+		d.Set("vpc_uuid", droplets[0].VPCUUID)
+		d.Set("tags", tag.FlattenTags(commonDropletGroupTags(droplets)))
+	}
+
+	// Report the group as it exists so that Droplets which were deleted
+	// outside of Terraform are created again on the next apply. The names
+	// are taken from the droplets attribute, which follows the configured
+	// order.
+	if _, ok := d.GetOk("name_prefix"); ok {
+		d.Set("droplet_count", len(droplets))
+	} else {
+		var names []string
+		for _, raw := range d.Get("droplets").([]interface{}) {
+			names = append(names, raw.(map[string]interface{})["name"].(string))
+		}
+		d.Set("names", names)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	var ids []int
+	for _, raw := range d.Get("droplets").([]interface{}) {
+		ids = append(ids, raw.(map[string]interface{})["id"].(int))
+	}
+
+	log.Printf("[INFO] Deleting %d droplets in droplet group (%s)", len(ids), d.Id())
+
+	var errs []string
+	for i, err := range deleteDropletGroupDroplets(ctx, client, ids, d.Timeout(schema.TimeoutDelete)) {
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("deleting droplet (%d): %s", ids[i], err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return diag.Errorf("Error deleting droplet group (%s):\n%s", d.Id(), strings.Join(errs, "\n"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// priorDropletGroupDroplets returns the Droplets recorded in state. The
+// droplets attribute is unknown during an apply which changes the group, so
+// the prior value has to be used.
+func priorDropletGroupDroplets(d *schema.ResourceData) []interface{} {
+	old, _ := d.GetChange("droplets")
+	if old == nil {
+		return nil
+	}
+	return old.([]interface{})
+}
+
+// expandDropletGroupNames returns the names of the Droplets that should be
+// in the group.
+func expandDropletGroupNames(d *schema.ResourceData) []string {
+	if prefix, ok := d.GetOk("name_prefix"); ok {
+		count := d.Get("droplet_count").(int)
+		names := make([]string, count)
+		for i := range names {
+			names[i] = fmt.Sprintf("%s-%d", prefix.(string), i+1)
+		}
+		return names
+	}
+
+	raw := d.Get("names").([]interface{})
+	names := make([]string, len(raw))
+	for i, name := range raw {
+		names[i] = name.(string)
+	}
+	return names
+}
+
+// createDropletGroupDroplets creates Droplets with the given names in batches
+// of dropletGroupBatchSize. It returns the Droplets that were created, even if
+// a later batch fails.
+func createDropletGroupDroplets(ctx context.Context, client *godo.Client, d *schema.ResourceData, names []string) ([]godo.Droplet, error) {
+	opts := &godo.DropletMultiCreateRequest{
+		Region:     d.Get("region").(string),
+		Size:       d.Get("size").(string),
+		IPv6:       d.Get("ipv6").(bool),
+		Monitoring: d.Get("monitoring").(bool),
+		Tags:       tag.ExpandTags(d.Get("tags").(*schema.Set).List()),
+	}
+
+	image := d.Get("image").(string)
+	if imageID, err := strconv.Atoi(image); err == nil {
+		opts.Image.ID = imageID
+	} else {
+		opts.Image.Slug = image
+	}
+
+	if attr, ok := d.GetOk("vpc_uuid"); ok {
+		opts.VPCUUID = attr.(string)
+	}
+
+	if attr, ok := d.GetOk("user_data"); ok {
+		opts.UserData = attr.(string)
+	}
+
+	if v, ok := d.GetOk("ssh_keys"); ok {
+		expandedSshKeys, err := expandSshKeys(v.(*schema.Set).List())
+		if err != nil {
+			return nil, err
+		}
+		opts.SSHKeys = expandedSshKeys
+	}
+
+	var created []godo.Droplet
+	for start := 0; start < len(names); start += dropletGroupBatchSize {
+		end := start + dropletGroupBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		opts.Names = names[start:end]
+
+		log.Printf("[DEBUG] Droplet group create configuration: %#v", opts)
+		droplets, _, err := client.Droplets.CreateMultiple(ctx, opts)
+		if err != nil {
+			return created, fmt.Errorf("creating droplets %s: %s", strings.Join(opts.Names, ", "), err)
+		}
+
+		created = append(created, droplets...)
+	}
+
+	return created, nil
+}
+
+// waitForDropletGroupDroplets waits for the Droplets to become active and
+// returns their latest state. Droplets which could not be retrieved are
+// omitted and reported as errors.
+func waitForDropletGroupDroplets(ctx context.Context, client *godo.Client, ids []int, timeout time.Duration) ([]godo.Droplet, []string) {
+	results := make([]*godo.Droplet, len(ids))
+	waitErrs := util.RunConcurrently(len(ids), dropletGroupConcurrency, func(i int) error {
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"new", "locked"},
+			Target:     []string{"active", "off"},
+			Refresh:    dropletGroupStateRefreshFunc(ctx, client, ids[i]),
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+
+			// This is a hack around DO API strangeness.
+			// https://github.com/hashicorp/terraform/issues/481
+			NotFoundChecks: 60,
+		}
+
+		droplet, err := stateConf.WaitForStateContext(ctx)
+		if droplet != nil {
+			results[i] = droplet.(*godo.Droplet)
+		}
+		return err
+	})
+
+	var droplets []godo.Droplet
+	var errs []string
+	for i, err := range waitErrs {
+		if results[i] != nil {
+			droplets = append(droplets, *results[i])
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("waiting for droplet (%d) to become ready: %s", ids[i], err))
+		}
+	}
+
+	return droplets, errs
+}
+
+func dropletGroupStateRefreshFunc(ctx context.Context, client *godo.Client, dropletID int) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		droplet, _, err := client.Droplets.Get(ctx, dropletID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving droplet: %s", err)
+		}
+
+		// Actions can only be performed on unlocked Droplets, so keep
+		// waiting until the Droplet is unlocked.
+		if droplet.Locked {
+			return droplet, "locked", nil
+		}
+
+		return droplet, droplet.Status, nil
+	}
+}
+
+// deleteDropletGroupDroplets deletes the Droplets and waits for them to be
+// gone, returning the result for each Droplet in the same order as ids.
+func deleteDropletGroupDroplets(ctx context.Context, client *godo.Client, ids []int, timeout time.Duration) []error {
+	return util.RunConcurrently(len(ids), dropletGroupConcurrency, func(i int) error {
+		resp, err := client.Droplets.Delete(ctx, ids[i])
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending: []string{"new", "active", "off", "archive"},
+			Target:  []string{"deleted"},
+			Refresh: func() (interface{}, string, error) {
+				droplet, resp, err := client.Droplets.Get(ctx, ids[i])
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return ids[i], "deleted", nil
+					}
+					return nil, "", err
+				}
+				return droplet, droplet.Status, nil
+			},
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		return err
+	})
+}
+
+// setDropletGroupTags updates the tags of all of the Droplets in the group
+// with one request per added or removed tag.
+func setDropletGroupTags(ctx context.Context, client *godo.Client, d *schema.ResourceData) error {
+	var resources []godo.Resource
+	for _, raw := range priorDropletGroupDroplets(d) {
+		resources = append(resources, godo.Resource{
+			ID:   strconv.Itoa(raw.(map[string]interface{})["id"].(int)),
+			Type: godo.DropletResourceType,
+		})
+	}
+
+	if len(resources) == 0 {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange("tags")
+	remove, create := tag.DiffTags(tag.TagsFromSchema(oraw), tag.TagsFromSchema(nraw))
+
+	for _, name := range remove {
+		_, err := client.Tags.UntagResources(ctx, name, &godo.UntagResourcesRequest{
+			Resources: resources,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range create {
+		createdTag, _, err := client.Tags.Create(ctx, &godo.TagCreateRequest{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Tags.TagResources(ctx, createdTag.Name, &godo.TagResourcesRequest{
+			Resources: resources,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commonDropletGroupTags returns the tags set on every Droplet of the group,
+// so that a tag removed from any of them is added back on the next apply.
+func commonDropletGroupTags(droplets []godo.Droplet) []string {
+	counts := make(map[string]int)
+	for _, droplet := range droplets {
+		seen := make(map[string]bool, len(droplet.Tags))
+		for _, t := range droplet.Tags {
+			if !seen[t] {
+				seen[t] = true
+				counts[t]++
+			}
+		}
+	}
+
+	var tags []string
+	for _, t := range droplets[0].Tags {
+		if counts[t] == len(droplets) {
+			tags = append(tags, t)
+			counts[t] = 0
+		}
+	}
+
+	return tags
+}
+
+// setDropletGroupDroplets sets the droplets attribute, ordering the Droplets
+// by their position in names. Droplets which are not in names are listed
+// last so they are not lost from state.
+func setDropletGroupDroplets(d *schema.ResourceData, droplets []godo.Droplet, names []string) error {
+	position := make(map[string]int, len(names))
+	for i, name := range names {
+		position[name] = i
+	}
+
+	ordered := make([]*godo.Droplet, len(names))
+	var extra []*godo.Droplet
+	for i := range droplets {
+		droplet := &droplets[i]
+		if pos, ok := position[droplet.Name]; ok && ordered[pos] == nil {
+			ordered[pos] = droplet
+		} else {
+			extra = append(extra, droplet)
+		}
+	}
+
+	flattened := make([]interface{}, 0, len(droplets))
+	for _, droplet := range append(ordered, extra...) {
+		if droplet == nil {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"id":                   droplet.ID,
+			"name":                 droplet.Name,
+			"urn":                  droplet.URN(),
+			"status":               droplet.Status,
+			"ipv4_address":         FindIPv4AddrByType(droplet, "public"),
+			"ipv4_address_private": FindIPv4AddrByType(droplet, "private"),
+			"ipv6_address":         strings.ToLower(FindIPv6AddrByType(droplet, "public")),
+		})
+	}
+
+	if err := d.Set("droplets", flattened); err != nil {
+		return fmt.Errorf("Error setting `droplets`: %+v", err)
+	}
+
+	return nil
+}
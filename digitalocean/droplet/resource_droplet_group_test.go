@@ -0,0 +1,210 @@
+package droplet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDropletGroup_Scale(t *testing.T) {
+	var firstID string
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletGroupConfig_prefix(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupExists("digitalocean_droplet_group.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.0.name", name+"-1"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.1.name", name+"-2"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.0.status", "active"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_group.foobar", "droplets.0.ipv4_address"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_group.foobar", "droplets.0.ipv4_address_private"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_group.foobar", "vpc_uuid"),
+					testAccCheckDigitalOceanDropletGroupAttr("digitalocean_droplet_group.foobar", "droplets.0.id", &firstID),
+				),
+			},
+			{
+				// Scaling up only creates the additional Droplet.
+				Config: testAccCheckDigitalOceanDropletGroupConfig_prefix(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupExists("digitalocean_droplet_group.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.#", "3"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.2.name", name+"-3"),
+					resource.TestCheckResourceAttrPtr("digitalocean_droplet_group.foobar", "droplets.0.id", &firstID),
+				),
+			},
+			{
+				// Scaling down only deletes the Droplets which are no longer wanted.
+				Config: testAccCheckDigitalOceanDropletGroupConfig_prefix(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupExists("digitalocean_droplet_group.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.0.name", name+"-1"),
+					resource.TestCheckResourceAttrPtr("digitalocean_droplet_group.foobar", "droplets.0.id", &firstID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletGroup_Names(t *testing.T) {
+	name := acceptance.RandomTestName()
+	tagName := acceptance.RandomTestName("tag")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_droplet_group" "foobar" {
+  names  = ["%[1]s-worker", "%[1]s-web"]
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  tags   = ["%[2]s"]
+}`, name, tagName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupExists("digitalocean_droplet_group.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.#", "2"),
+					// The names are not in alphabetical order, so that the
+					// configured order must be kept for the plan to be empty.
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.0.name", name+"-worker"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "droplets.1.name", name+"-web"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "names.0", name+"-worker"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "names.1", name+"-web"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_group.foobar", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("digitalocean_droplet_group.foobar", "tags.*", tagName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletGroup_DuplicateNames(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_droplet_group" "foobar" {
+  names  = ["web", "web"]
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`names must be unique, "web" is listed more than once`),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDropletGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Droplet group ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["droplets.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			id, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("droplets.%d.id", i)])
+			if err != nil {
+				return err
+			}
+
+			droplet, _, err := client.Droplets.Get(context.Background(), id)
+			if err != nil {
+				return err
+			}
+
+			if name := rs.Primary.Attributes[fmt.Sprintf("droplets.%d.name", i)]; droplet.Name != name {
+				return fmt.Errorf("Droplet %d has name %s, expected %s", id, droplet.Name, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanDropletGroupAttr(n string, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*value = rs.Primary.Attributes[key]
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanDropletGroupDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_droplet_group" {
+			continue
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["droplets.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			id, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("droplets.%d.id", i)])
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.Droplets.Get(context.Background(), id)
+			if err == nil {
+				return fmt.Errorf("Droplet %d of group %s still exists", id, rs.Primary.ID)
+			}
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanDropletGroupConfig_prefix(name string, count int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet_group" "foobar" {
+  name_prefix   = "%s"
+  droplet_count = %d
+  size          = "s-1vcpu-1gb"
+  image         = "ubuntu-22-04-x64"
+  region        = "nyc3"
+}`, name, count)
+}
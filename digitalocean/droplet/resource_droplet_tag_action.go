@@ -0,0 +1,211 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	dropletTagActionPowerOn        = "power_on"
+	dropletTagActionPowerOff       = "power_off"
+	dropletTagActionShutdown       = "shutdown"
+	dropletTagActionPowerCycle     = "power_cycle"
+	dropletTagActionSnapshot       = "snapshot"
+	dropletTagActionEnableBackups  = "enable_backups"
+	dropletTagActionDisableBackups = "disable_backups"
+)
+
+func ResourceDigitalOceanDropletTagAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDropletTagActionCreate,
+		ReadContext:   resourceDigitalOceanDropletTagActionRead,
+		UpdateContext: resourceDigitalOceanDropletTagActionUpdate,
+		DeleteContext: resourceDigitalOceanDropletTagActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The tag of the Droplets to run the action against",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of action to run",
+				ValidateFunc: validation.StringInSlice([]string{
+					dropletTagActionPowerOn,
+					dropletTagActionPowerOff,
+					dropletTagActionShutdown,
+					dropletTagActionPowerCycle,
+					dropletTagActionSnapshot,
+					dropletTagActionEnableBackups,
+					dropletTagActionDisableBackups,
+				}, false),
+			},
+			"snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The name to give the snapshots taken by a snapshot action",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The maximum number of actions to wait on at the same time",
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary values that, when changed, cause the action to be run again",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the action for each Droplet carrying the tag",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"droplet_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the Droplet",
+						},
+						"action_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the action run against the Droplet",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The final status of the action",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error encountered while waiting for the action, if any",
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			actionType := d.Get("type").(string)
+			_, hasName := d.GetOk("snapshot_name")
+			hasName = hasName || !d.NewValueKnown("snapshot_name")
+
+			if actionType == dropletTagActionSnapshot && !hasName {
+				return fmt.Errorf("snapshot_name is required for %s actions", actionType)
+			}
+			if actionType != dropletTagActionSnapshot && hasName {
+				return fmt.Errorf("snapshot_name can only be set for %s actions", dropletTagActionSnapshot)
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceDigitalOceanDropletTagActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	tag := d.Get("tag").(string)
+	actionType := d.Get("type").(string)
+
+	log.Printf("[INFO] Running %s action on droplets tagged %s", actionType, tag)
+
+	var actions []godo.Action
+	var err error
+	switch actionType {
+	case dropletTagActionPowerOn:
+		actions, _, err = client.DropletActions.PowerOnByTag(context.Background(), tag)
+	case dropletTagActionPowerOff:
+		actions, _, err = client.DropletActions.PowerOffByTag(context.Background(), tag)
+	case dropletTagActionShutdown:
+		actions, _, err = client.DropletActions.ShutdownByTag(context.Background(), tag)
+	case dropletTagActionPowerCycle:
+		actions, _, err = client.DropletActions.PowerCycleByTag(context.Background(), tag)
+	case dropletTagActionSnapshot:
+		actions, _, err = client.DropletActions.SnapshotByTag(context.Background(), tag, d.Get("snapshot_name").(string))
+	case dropletTagActionEnableBackups:
+		actions, _, err = client.DropletActions.EnableBackupsByTag(context.Background(), tag)
+	case dropletTagActionDisableBackups:
+		actions, _, err = client.DropletActions.DisableBackupsByTag(context.Background(), tag)
+	default:
+		return diag.Errorf("unsupported droplet tag action type: %s", actionType)
+	}
+	if err != nil {
+		return diag.Errorf("Error running %s action on droplets tagged %s: %s", actionType, tag, err)
+	}
+
+	d.SetId(id.PrefixedUniqueId(tag + "-"))
+	log.Printf("[INFO] Waiting for %d %s actions on droplets tagged %s", len(actions), actionType, tag)
+
+	errs := util.WaitForActions(client, actions, d.Get("max_concurrency").(int))
+
+	results := make([]interface{}, len(actions))
+	var failed []string
+	for i, action := range actions {
+		result := map[string]interface{}{
+			"droplet_id": action.ResourceID,
+			"action_id":  action.ID,
+			"status":     "completed",
+			"error":      "",
+		}
+
+		if errs[i] != nil {
+			result["status"] = "errored"
+			result["error"] = errs[i].Error()
+			failed = append(failed, fmt.Sprintf("droplet %d (action %d): %s", action.ResourceID, action.ID, errs[i]))
+		}
+
+		results[i] = result
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return diag.Errorf("Error setting results: %s", err)
+	}
+
+	if len(failed) > 0 {
+		return diag.Errorf("%d of %d %s actions on droplets tagged %s failed:\n%s",
+			len(failed), len(actions), actionType, tag, strings.Join(failed, "\n"))
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletTagActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The results are recorded when the actions are run. They are not
+	// refreshed to avoid an API request per Droplet on every plan.
+	return nil
+}
+
+func resourceDigitalOceanDropletTagActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only max_concurrency can be updated, which only affects future runs.
+	return resourceDigitalOceanDropletTagActionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDropletTagActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Actions cannot be undone, so there is nothing to do other than
+	// removing the resource from state.
+	d.SetId("")
+	return nil
+}
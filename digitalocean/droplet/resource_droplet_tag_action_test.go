@@ -0,0 +1,88 @@
+package droplet_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanDropletTagAction_PowerCycle(t *testing.T) {
+	name := acceptance.RandomTestName()
+	tagName := acceptance.RandomTestName("tag")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletTagActionConfig_powerCycle(tagName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_droplet_tag_action.foobar", "type", "power_cycle"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_tag_action.foobar", "tag", tagName),
+					resource.TestCheckResourceAttr("digitalocean_droplet_tag_action.foobar", "max_concurrency", "1"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_tag_action.foobar", "results.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_tag_action.foobar", "results.0.status", "completed"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_tag_action.foobar", "results.1.status", "completed"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_tag_action.foobar", "results.0.droplet_id"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_tag_action.foobar", "results.0.action_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletTagAction_InvalidArguments(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_droplet_tag_action" "foobar" {
+  tag  = "web"
+  type = "snapshot"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("snapshot_name is required for snapshot actions"),
+			},
+			{
+				Config: `
+resource "digitalocean_droplet_tag_action" "foobar" {
+  tag           = "web"
+  type          = "power_off"
+  snapshot_name = "web-snapshot"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("snapshot_name can only be set for snapshot actions"),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDropletTagActionConfig_powerCycle(tagName string, name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_tag" "foobar" {
+  name = "%[1]s"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%[2]s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+  tags   = [digitalocean_tag.foobar.id]
+}
+
+resource "digitalocean_droplet_tag_action" "foobar" {
+  tag             = digitalocean_tag.foobar.name
+  type            = "power_cycle"
+  max_concurrency = 1
+
+  depends_on = [digitalocean_droplet.foobar]
+}`, tagName, name)
+}
@@ -1,13 +1,16 @@
 package droplet_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -552,6 +555,141 @@ func TestAccDigitalOceanDroplet_ChangeBackupPolicy(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_BackupPolicyDrift(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+	weeklyPolicy := `  backup_policy {
+		plan    = "weekly"
+		weekday = "MON"
+		hour    = 0
+	}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_ChangeBackupPolicy(name, `backups = true`, weeklyPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.plan", "weekly"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet.foobar", "backup_policy.0.window_length_hours"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet.foobar", "backup_policy.0.retention_period_days"),
+				),
+			},
+			{
+				// The live policy is imported along with the Droplet.
+				ResourceName:      "digitalocean_droplet.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ssh_keys", "user_data", "resize_disk", "graceful_shutdown"},
+			},
+			{
+				// Changing the policy outside of Terraform is detected as drift.
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+					action, _, err := client.DropletActions.ChangeBackupPolicy(context.Background(), droplet.ID,
+						&godo.DropletBackupPolicyRequest{Plan: "weekly", Weekday: "FRI", Hour: godo.PtrTo(0)})
+					if err != nil {
+						t.Fatalf("Error changing backup policy: %s", err)
+					}
+					if err := util.WaitForAction(client, action); err != nil {
+						t.Fatalf("Error waiting for backup policy to change: %s", err)
+					}
+				},
+				Config:             testAccCheckDigitalOceanDropletConfig_ChangeBackupPolicy(name, `backups = true`, weeklyPolicy),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Removing the block resets the default policy.
+				Config: testAccCheckDigitalOceanDropletConfig_ChangeBackupPolicy(name, `backups = true`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "backup_policy.0.plan", "daily"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_UnsupportedBackupPolicy(t *testing.T) {
+	name := acceptance.RandomTestName()
+	policy := `  backup_policy {
+		plan    = "weekly"
+		weekday = "MON"
+		hour    = 3
+	}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanDropletConfig_ChangeBackupPolicy(name, `backups = true`, policy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`backup_policy hour 3 is not supported for the weekly plan`),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_AntiAffinityTag(t *testing.T) {
+	var first, second godo.Droplet
+	name := acceptance.RandomTestName()
+	tagName := acceptance.RandomTestName("tag")
+
+	// Whether the Droplets land on the same host is up to the scheduler, so
+	// the check only ensures that the warning never fails the apply.
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "replicas" {
+  name = "%[1]s"
+}
+
+resource "digitalocean_droplet" "first" {
+  name              = "%[2]s-1"
+  size              = "%[3]s"
+  image             = "%[4]s"
+  region            = "nyc3"
+  tags              = [digitalocean_tag.replicas.id]
+  anti_affinity_tag = digitalocean_tag.replicas.id
+}
+
+resource "digitalocean_droplet" "second" {
+  name              = "%[2]s-2"
+  size              = "%[3]s"
+  image             = "%[4]s"
+  region            = "nyc3"
+  tags              = [digitalocean_tag.replicas.id]
+  anti_affinity_tag = digitalocean_tag.replicas.id
+}
+`, tagName, name, defaultSize, defaultImage)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.first", &first),
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.second", &second),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.first", "anti_affinity_tag", tagName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.second", "anti_affinity_tag", tagName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDroplet_WithBackupPolicy(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
@@ -815,6 +953,85 @@ resource "digitalocean_droplet" "foobar" {
 	})
 }
 
+func TestAccDigitalOceanDroplet_DestroyAllAssociatedResources(t *testing.T) {
+	var droplet godo.Droplet
+	var snapshotIDs []int
+	dropletName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acceptance.TestAccCheckDigitalOceanDropletDestroy,
+			testAccCheckDigitalOceanDropletSnapshotsDestroyed(&snapshotIDs),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+
+  destroy_associated_resources {
+    all = true
+  }
+}`, dropletName, defaultSize, defaultImage),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "destroy_associated_resources.0.all", "true"),
+					acceptance.TakeSnapshotsOfDroplet(dropletName, &droplet, &snapshotIDs),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_DestroyAssociatedResourcesConflict(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "foobar"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+
+  destroy_associated_resources {
+    all          = true
+    snapshot_ids = ["12345"]
+  }
+}`, defaultSize, defaultImage),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`conflicts with destroy_associated_resources.0.snapshot_ids`),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDropletSnapshotsDestroyed(snapshotIDs *[]int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		for _, id := range *snapshotIDs {
+			_, resp, err := client.Images.GetByID(context.Background(), id)
+			if err == nil {
+				return fmt.Errorf("Droplet snapshot %d still exists", id)
+			}
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDigitalOceanDropletAttributes(droplet *godo.Droplet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -0,0 +1,94 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanFunctionsNamespace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanFunctionsNamespaceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of the Functions namespace",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"id", "label"},
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The label of the Functions namespace",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"id", "label"},
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the Functions namespace is located",
+			},
+			"api_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API host for the Functions namespace",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the Functions namespace",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to authenticate with the Functions namespace",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the Functions namespace was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the Functions namespace was last updated",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanFunctionsNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	var namespaceID string
+	if id, ok := d.GetOk("id"); ok {
+		namespaceID = id.(string)
+	} else {
+		namespaces, _, err := client.Functions.ListNamespaces(ctx)
+		if err != nil {
+			return diag.Errorf("Error retrieving Functions namespaces: %s", err)
+		}
+
+		found, err := findFunctionsNamespaceByLabel(namespaces, d.Get("label").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		namespaceID = found.Namespace
+	}
+
+	namespace, _, err := client.Functions.GetNamespace(ctx, namespaceID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Functions namespace: %s", err)
+	}
+
+	setFunctionsNamespaceAttributes(d, namespace)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanFunctionsNamespace_ByLabel(t *testing.T) {
+	label := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic, label)
+	dataSourceConfig := `
+data "digitalocean_functions_namespace" "foobar" {
+  label = digitalocean_functions_namespace.foobar.label
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_functions_namespace.foobar", "id", "digitalocean_functions_namespace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_functions_namespace.foobar", "label", label),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_functions_namespace.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_functions_namespace.foobar", "api_host", "digitalocean_functions_namespace.foobar", "api_host"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_functions_namespace.foobar", "uuid", "digitalocean_functions_namespace.foobar", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanFunctionsNamespace_ByID(t *testing.T) {
+	label := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic, label)
+	dataSourceConfig := `
+data "digitalocean_functions_namespace" "foobar" {
+  id = digitalocean_functions_namespace.foobar.id
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_functions_namespace.foobar", "label", label),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_functions_namespace.foobar", "key", "digitalocean_functions_namespace.foobar", "key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanFunctionsNamespaces() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        functionsNamespaceSchema(),
+		ResultAttributeName: "namespaces",
+		GetRecords:          getDigitalOceanFunctionsNamespaces,
+		FlattenRecord:       flattenDigitalOceanFunctionsNamespace,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,57 @@
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanFunctionsNamespaces_Basic(t *testing.T) {
+	label1 := acceptance.RandomTestName()
+	label2 := acceptance.RandomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_functions_namespace" "foo" {
+  label  = "%s"
+  region = "nyc1"
+}
+
+resource "digitalocean_functions_namespace" "bar" {
+  label  = "%s"
+  region = "nyc1"
+}
+`, label1, label2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_functions_namespaces" "result" {
+  filter {
+    key    = "label"
+    values = ["%s"]
+  }
+}
+`, label1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_functions_namespaces.result", "namespaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_functions_namespaces.result", "namespaces.0.id", "digitalocean_functions_namespace.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_functions_namespaces.result", "namespaces.0.label", "digitalocean_functions_namespace.foo", "label"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_functions_namespaces.result", "namespaces.0.api_host", "digitalocean_functions_namespace.foo", "api_host"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func functionsNamespaceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the Functions namespace",
+		},
+		"label": {
+			Type:        schema.TypeString,
+			Description: "The label of the Functions namespace",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "The region where the Functions namespace is located",
+		},
+		"api_host": {
+			Type:        schema.TypeString,
+			Description: "The API host for the Functions namespace",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "The UUID of the Functions namespace",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date and time when the Functions namespace was created",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "The date and time when the Functions namespace was last updated",
+		},
+	}
+}
+
+func getDigitalOceanFunctionsNamespaces(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespaces, _, err := client.Functions.ListNamespaces(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Functions namespaces: %s", err)
+	}
+
+	var namespaceList []interface{}
+	for _, ns := range namespaces {
+		namespaceList = append(namespaceList, ns)
+	}
+
+	return namespaceList, nil
+}
+
+func flattenDigitalOceanFunctionsNamespace(rawNamespace, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	namespace := rawNamespace.(godo.FunctionsNamespace)
+
+	flattenedNamespace := map[string]interface{}{
+		"id":         namespace.Namespace,
+		"label":      namespace.Label,
+		"region":     namespace.Region,
+		"api_host":   namespace.ApiHost,
+		"uuid":       namespace.UUID,
+		"created_at": namespace.CreatedAt.UTC().Format(time.RFC3339),
+		"updated_at": namespace.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	return flattenedNamespace, nil
+}
+
+func findFunctionsNamespaceByLabel(namespaces []godo.FunctionsNamespace, label string) (*godo.FunctionsNamespace, error) {
+	var found []godo.FunctionsNamespace
+	for _, ns := range namespaces {
+		if ns.Label == label {
+			found = append(found, ns)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no Functions namespace found with label %s", label)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("too many Functions namespaces found with label %s (found %d, expected 1)", label, len(found))
+	}
+}
+
+func setFunctionsNamespaceAttributes(d *schema.ResourceData, namespace *godo.FunctionsNamespace) {
+	d.SetId(namespace.Namespace)
+	d.Set("label", namespace.Label)
+	d.Set("region", namespace.Region)
+	d.Set("api_host", namespace.ApiHost)
+	d.Set("uuid", namespace.UUID)
+	d.Set("key", namespace.Key)
+	d.Set("created_at", namespace.CreatedAt.UTC().Format(time.RFC3339))
+	d.Set("updated_at", namespace.UpdatedAt.UTC().Format(time.RFC3339))
+}
+
+// cronField describes the allowed values for a single field of a standard
+// five-field cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// ValidateCronExpression is a schema.SchemaValidateFunc ensuring that a value
+// is a standard five-field cron expression as accepted by scheduled triggers.
+func ValidateCronExpression(v interface{}, k string) ([]string, []error) {
+	expr, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, []error{fmt.Errorf("%s: expected a cron expression with %d fields (minute hour day-of-month month day-of-week), got %d: %q", k, len(cronFields), len(fields), expr)}
+	}
+
+	var errs []error
+	for i, field := range fields {
+		if err := validateCronField(field, cronFields[i]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid %s field %q: %s", k, cronFields[i].name, field, err))
+		}
+	}
+
+	return nil, errs
+}
+
+func validateCronField(field string, spec cronField) error {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("step %q must be a positive integer", step)
+			}
+		}
+
+		if rangePart == "*" {
+			continue
+		}
+
+		low, high, isRange := strings.Cut(rangePart, "-")
+		lowValue, err := parseCronValue(low, spec)
+		if err != nil {
+			return err
+		}
+
+		if !isRange {
+			continue
+		}
+
+		highValue, err := parseCronValue(high, spec)
+		if err != nil {
+			return err
+		}
+		if lowValue > highValue {
+			return fmt.Errorf("range start %q is after range end %q", low, high)
+		}
+	}
+
+	return nil
+}
+
+func parseCronValue(value string, spec cronField) (int, error) {
+	for i, name := range spec.names {
+		if strings.EqualFold(value, name) {
+			// Month names start at 1, weekday names at 0.
+			return spec.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+	if n < spec.min || n > spec.max {
+		return 0, fmt.Errorf("%d is outside the allowed range %d-%d", n, spec.min, spec.max)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,45 @@
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanFunctionsTrigger_importBasic(t *testing.T) {
+	namespace, function := testAccFunctionsTriggerPreCheck(t)
+
+	resourceName := "digitalocean_functions_trigger.foobar"
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanFunctionsTriggerConfig_Basic, namespace, name, function, true, "0 2 * * *"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccFunctionsTriggerImportID(resourceName),
+			},
+		},
+	})
+}
+
+func testAccFunctionsTriggerImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["namespace_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
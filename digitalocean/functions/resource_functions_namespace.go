@@ -0,0 +1,122 @@
+package functions
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanFunctionsNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFunctionsNamespaceCreate,
+		ReadContext:   resourceDigitalOceanFunctionsNamespaceRead,
+		DeleteContext: resourceDigitalOceanFunctionsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The label of the Functions namespace",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The region where the Functions namespace is located",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"api_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API host for the Functions namespace",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the Functions namespace",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to authenticate with the Functions namespace",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the Functions namespace was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the Functions namespace was last updated",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanFunctionsNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.FunctionsNamespaceCreateRequest{
+		Label:  d.Get("label").(string),
+		Region: d.Get("region").(string),
+	}
+
+	log.Printf("[DEBUG] Functions namespace create request: %#v", opts)
+	namespace, _, err := client.Functions.CreateNamespace(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Error creating Functions namespace: %s", err)
+	}
+
+	d.SetId(namespace.Namespace)
+	log.Printf("[INFO] Functions namespace created, ID: %s", d.Id())
+
+	return resourceDigitalOceanFunctionsNamespaceRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionsNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespace, resp, err := client.Functions.GetNamespace(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] Functions namespace (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading Functions namespace: %s", err)
+	}
+
+	setFunctionsNamespaceAttributes(d, namespace)
+
+	return nil
+}
+
+func resourceDigitalOceanFunctionsNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting Functions namespace: %s", d.Id())
+	resp, err := client.Functions.DeleteNamespace(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting Functions namespace: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,96 @@
+package functions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanFunctionsNamespace_Basic(t *testing.T) {
+	var namespace godo.FunctionsNamespace
+	label := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic, label),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFunctionsNamespaceExists("digitalocean_functions_namespace.foobar", &namespace),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_namespace.foobar", "label", label),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_namespace.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "api_host"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "key"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanFunctionsNamespaceDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_functions_namespace" {
+			continue
+		}
+
+		_, _, err := client.Functions.GetNamespace(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Functions namespace still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanFunctionsNamespaceExists(resource string, namespace *godo.FunctionsNamespace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set for resource: %s", resource)
+		}
+
+		foundNamespace, _, err := client.Functions.GetNamespace(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundNamespace.Namespace != rs.Primary.ID {
+			return fmt.Errorf("Resource not found: %s : %s", resource, rs.Primary.ID)
+		}
+
+		*namespace = *foundNamespace
+
+		return nil
+	}
+}
+
+const testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic = `
+resource "digitalocean_functions_namespace" "foobar" {
+  label  = "%s"
+  region = "nyc1"
+}
+`
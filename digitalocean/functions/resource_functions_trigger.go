@@ -0,0 +1,327 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const functionsTriggerTypeScheduled = "SCHEDULED"
+
+func ResourceDigitalOceanFunctionsTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFunctionsTriggerCreate,
+		ReadContext:   resourceDigitalOceanFunctionsTriggerRead,
+		UpdateContext: resourceDigitalOceanFunctionsTriggerUpdate,
+		DeleteContext: resourceDigitalOceanFunctionsTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanFunctionsTriggerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the Functions namespace the trigger belongs to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the trigger",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"function": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the function invoked by the trigger, including its package, e.g. `package/function`",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      functionsTriggerTypeScheduled,
+				Description:  "The type of the trigger",
+				ValidateFunc: validation.StringInSlice([]string{functionsTriggerTypeScheduled}, false),
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the trigger is enabled",
+			},
+			"cron": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The cron expression defining when the function is invoked",
+				ValidateFunc: ValidateCronExpression,
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "A JSON object passed to the function as parameters on each invocation",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"scheduled_runs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information about the trigger's scheduled invocations",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_run_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time when the function was last invoked",
+						},
+						"next_run_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time when the function will next be invoked",
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the trigger was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time when the trigger was last updated",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanFunctionsTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespaceID := d.Get("namespace_id").(string)
+	details, err := expandFunctionsTriggerScheduledDetails(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &godo.FunctionsTriggerCreateRequest{
+		Name:             d.Get("name").(string),
+		Type:             d.Get("type").(string),
+		Function:         d.Get("function").(string),
+		IsEnabled:        d.Get("is_enabled").(bool),
+		ScheduledDetails: details,
+	}
+
+	log.Printf("[DEBUG] Functions trigger create request: %#v", opts)
+	trigger, _, err := client.Functions.CreateTrigger(ctx, namespaceID, opts)
+	if err != nil {
+		return diag.Errorf("Error creating Functions trigger: %s", err)
+	}
+
+	d.SetId(createFunctionsTriggerID(namespaceID, trigger.Name))
+	log.Printf("[INFO] Functions trigger created, ID: %s", d.Id())
+
+	return resourceDigitalOceanFunctionsTriggerRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionsTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	namespaceID, name := splitFunctionsTriggerID(d.Id())
+
+	trigger, resp, err := client.Functions.GetTrigger(ctx, namespaceID, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] Functions trigger (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading Functions trigger: %s", err)
+	}
+
+	d.Set("namespace_id", namespaceID)
+	d.Set("name", trigger.Name)
+	d.Set("function", trigger.Function)
+	d.Set("type", trigger.Type)
+	d.Set("is_enabled", trigger.IsEnabled)
+	d.Set("created_at", trigger.CreatedAt.UTC().Format(time.RFC3339))
+	d.Set("updated_at", trigger.UpdatedAt.UTC().Format(time.RFC3339))
+
+	if trigger.ScheduledDetails != nil {
+		d.Set("cron", trigger.ScheduledDetails.Cron)
+
+		body := ""
+		if len(trigger.ScheduledDetails.Body) > 0 {
+			b, err := json.Marshal(trigger.ScheduledDetails.Body)
+			if err != nil {
+				return diag.Errorf("Error encoding Functions trigger body: %s", err)
+			}
+			body = string(b)
+		}
+		d.Set("body", body)
+	}
+
+	if err := d.Set("scheduled_runs", flattenFunctionsTriggerScheduledRuns(trigger.ScheduledRuns)); err != nil {
+		return diag.Errorf("Error setting scheduled_runs: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanFunctionsTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	namespaceID, name := splitFunctionsTriggerID(d.Id())
+
+	opts := &godo.FunctionsTriggerUpdateRequest{}
+
+	if d.HasChange("is_enabled") {
+		opts.IsEnabled = godo.PtrTo(d.Get("is_enabled").(bool))
+	}
+
+	if d.HasChanges("cron", "body") {
+		details, err := expandFunctionsTriggerScheduledDetails(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts.ScheduledDetails = details
+	}
+
+	var err error
+	if opts.ScheduledDetails != nil && opts.ScheduledDetails.Body == nil && d.HasChange("body") {
+		// godo omits an empty body, which the API treats as keeping the
+		// previous one, so clearing it needs an explicit empty object.
+		log.Printf("[DEBUG] Functions trigger update request clearing body: %#v", opts)
+		_, err = clearFunctionsTriggerBody(ctx, client, namespaceID, name, opts)
+	} else {
+		log.Printf("[DEBUG] Functions trigger update request: %#v", opts)
+		_, _, err = client.Functions.UpdateTrigger(ctx, namespaceID, name, opts)
+	}
+	if err != nil {
+		return diag.Errorf("Error updating Functions trigger: %s", err)
+	}
+
+	return resourceDigitalOceanFunctionsTriggerRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionsTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	namespaceID, name := splitFunctionsTriggerID(d.Id())
+
+	log.Printf("[INFO] Deleting Functions trigger: %s", d.Id())
+	resp, err := client.Functions.DeleteTrigger(ctx, namespaceID, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting Functions trigger: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanFunctionsTriggerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(createFunctionsTriggerID(s[0], s[1]))
+		d.Set("namespace_id", s[0])
+		d.Set("name", s[1])
+	} else {
+		return nil, errors.New("must use the ID of the Functions namespace and the name of the trigger joined with a comma (e.g. `namespace_id,name`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// functionsTriggerClearBodyRequest mirrors godo.FunctionsTriggerUpdateRequest
+// without omitting an empty body.
+type functionsTriggerClearBodyRequest struct {
+	IsEnabled        *bool `json:"is_enabled,omitempty"`
+	ScheduledDetails struct {
+		Cron string                 `json:"cron"`
+		Body map[string]interface{} `json:"body"`
+	} `json:"scheduled_details"`
+}
+
+func clearFunctionsTriggerBody(ctx context.Context, client *godo.Client, namespaceID, name string, opts *godo.FunctionsTriggerUpdateRequest) (*godo.Response, error) {
+	request := &functionsTriggerClearBodyRequest{IsEnabled: opts.IsEnabled}
+	request.ScheduledDetails.Cron = opts.ScheduledDetails.Cron
+	request.ScheduledDetails.Body = map[string]interface{}{}
+
+	path := fmt.Sprintf("/v2/functions/namespaces/%s/triggers/%s", namespaceID, name)
+	req, err := client.NewRequest(ctx, http.MethodPut, path, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+func expandFunctionsTriggerScheduledDetails(d *schema.ResourceData) (*godo.TriggerScheduledDetails, error) {
+	details := &godo.TriggerScheduledDetails{
+		Cron: d.Get("cron").(string),
+	}
+
+	if v, ok := d.GetOk("body"); ok {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &body); err != nil {
+			return nil, fmt.Errorf("body must be a JSON object: %s", err)
+		}
+		details.Body = body
+	}
+
+	return details, nil
+}
+
+func flattenFunctionsTriggerScheduledRuns(runs *godo.TriggerScheduledRuns) []interface{} {
+	if runs == nil {
+		return nil
+	}
+
+	flattened := map[string]interface{}{
+		"last_run_at": "",
+		"next_run_at": "",
+	}
+	if !runs.LastRunAt.IsZero() {
+		flattened["last_run_at"] = runs.LastRunAt.UTC().Format(time.RFC3339)
+	}
+	if !runs.NextRunAt.IsZero() {
+		flattened["next_run_at"] = runs.NextRunAt.UTC().Format(time.RFC3339)
+	}
+
+	return []interface{}{flattened}
+}
+
+func createFunctionsTriggerID(namespaceID string, name string) string {
+	return fmt.Sprintf("%s/%s", namespaceID, name)
+}
+
+func splitFunctionsTriggerID(id string) (string, string) {
+	splitID := strings.SplitN(id, "/", 2)
+	if len(splitID) != 2 {
+		return splitID[0], ""
+	}
+
+	return splitID[0], splitID[1]
+}
@@ -0,0 +1,193 @@
+package functions_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/functions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	testFunctionsNamespaceEnvVar = "DO_TEST_FUNCTIONS_NAMESPACE"
+	testFunctionEnvVar           = "DO_TEST_FUNCTION"
+)
+
+// Triggers can only be created for functions which have already been
+// deployed, which is done out of band with doctl.
+func testAccFunctionsTriggerPreCheck(t *testing.T) (string, string) {
+	namespace := os.Getenv(testFunctionsNamespaceEnvVar)
+	function := os.Getenv(testFunctionEnvVar)
+	if namespace == "" || function == "" {
+		t.Skipf("Test requires a deployed function. Set %s and %s", testFunctionsNamespaceEnvVar, testFunctionEnvVar)
+	}
+
+	return namespace, function
+}
+
+func TestAccDigitalOceanFunctionsTrigger_Basic(t *testing.T) {
+	namespace, function := testAccFunctionsTriggerPreCheck(t)
+
+	var trigger godo.FunctionsTrigger
+	name := acceptance.RandomTestName()
+	createConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsTriggerConfig_Basic, namespace, name, function, true, "0 2 * * *")
+	updateConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsTriggerConfig_Basic, namespace, name, function, false, "30 3 * * MON-FRI")
+	noBodyConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsTriggerConfig_NoBody, namespace, name, function, false, "30 3 * * MON-FRI")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFunctionsTriggerExists("digitalocean_functions_trigger.foobar", &trigger),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "function", function),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "type", "SCHEDULED"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "is_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "cron", "0 2 * * *"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "body", `{"mode":"nightly"}`),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_trigger.foobar", "created_at"),
+				),
+			},
+			{
+				Config: updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFunctionsTriggerExists("digitalocean_functions_trigger.foobar", &trigger),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "is_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "cron", "30 3 * * MON-FRI"),
+				),
+			},
+			{
+				Config: noBodyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFunctionsTriggerExists("digitalocean_functions_trigger.foobar", &trigger),
+					func(s *terraform.State) error {
+						if trigger.ScheduledDetails != nil && len(trigger.ScheduledDetails.Body) > 0 {
+							return fmt.Errorf("Expected body to be cleared, found: %v", trigger.ScheduledDetails.Body)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_trigger.foobar", "body", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	cases := []struct {
+		expr  string
+		valid bool
+	}{
+		{expr: "* * * * *", valid: true},
+		{expr: "0 2 * * *", valid: true},
+		{expr: "*/15 0-6 1,15 * *", valid: true},
+		{expr: "30 3 * JAN-JUN MON-FRI", valid: true},
+		{expr: "0 0 * * 7", valid: true},
+		{expr: "0-30/5 * * * *", valid: true},
+		{expr: "", valid: false},
+		{expr: "* * * *", valid: false},
+		{expr: "* * * * * *", valid: false},
+		{expr: "60 * * * *", valid: false},
+		{expr: "* 24 * * *", valid: false},
+		{expr: "* * 0 * *", valid: false},
+		{expr: "* * * 13 *", valid: false},
+		{expr: "* * * * 8", valid: false},
+		{expr: "*/0 * * * *", valid: false},
+		{expr: "30-10 * * * *", valid: false},
+		{expr: "@daily", valid: false},
+	}
+
+	for _, tc := range cases {
+		_, errs := functions.ValidateCronExpression(tc.expr, "cron")
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", tc.expr, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", tc.expr)
+		}
+	}
+}
+
+func testAccCheckDigitalOceanFunctionsTriggerDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_functions_trigger" {
+			continue
+		}
+
+		_, _, err := client.Functions.GetTrigger(context.Background(), rs.Primary.Attributes["namespace_id"], rs.Primary.Attributes["name"])
+		if err == nil {
+			return fmt.Errorf("Functions trigger still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanFunctionsTriggerExists(resource string, trigger *godo.FunctionsTrigger) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set for resource: %s", resource)
+		}
+
+		foundTrigger, _, err := client.Functions.GetTrigger(context.Background(), rs.Primary.Attributes["namespace_id"], rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+
+		*trigger = *foundTrigger
+
+		return nil
+	}
+}
+
+const testAccCheckDigitalOceanFunctionsTriggerConfig_Basic = `
+resource "digitalocean_functions_trigger" "foobar" {
+  namespace_id = "%s"
+  name         = "%s"
+  function     = "%s"
+  is_enabled   = %t
+  cron         = "%s"
+  body = jsonencode({
+    mode = "nightly"
+  })
+}
+`
+
+const testAccCheckDigitalOceanFunctionsTriggerConfig_NoBody = `
+resource "digitalocean_functions_trigger" "foobar" {
+  namespace_id = "%s"
+  name         = "%s"
+  function     = "%s"
+  is_enabled   = %t
+  cron         = "%s"
+}
+`
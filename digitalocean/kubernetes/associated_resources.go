@@ -0,0 +1,302 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	associatedLoadBalancerType   = "load_balancer"
+	associatedVolumeType         = "volume"
+	associatedVolumeSnapshotType = "volume_snapshot"
+)
+
+func kubernetesDestroyAssociatedResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "The associated resources to destroy along with the cluster",
+		ConflictsWith: []string{"destroy_all_associated_resources"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"load_balancer_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the load balancers to destroy",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"volume_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the volumes to destroy",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"volume_snapshot_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the volume snapshots to destroy",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"match": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Matchers selecting associated resources to destroy by type, name and tags",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									associatedLoadBalancerType,
+									associatedVolumeType,
+									associatedVolumeSnapshotType,
+								}, false),
+							},
+							"name_regex": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "A regular expression the name of the resource must match",
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							"tags": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "Tags of which the resource must carry at least one",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kubernetesAssociatedResourcesSchema() *schema.Schema {
+	resourceList := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The resources associated with the cluster which can be destroyed along with it",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"load_balancers":   resourceList("The load balancers associated with the cluster"),
+				"volumes":          resourceList("The volumes associated with the cluster"),
+				"volume_snapshots": resourceList("The volume snapshots associated with the cluster"),
+			},
+		},
+	}
+}
+
+func flattenKubernetesAssociatedResources(associated *godo.KubernetesAssociatedResources) []interface{} {
+	if associated == nil {
+		return nil
+	}
+
+	flatten := func(resources []*godo.AssociatedResource) []interface{} {
+		flattened := make([]interface{}, 0, len(resources))
+		for _, resource := range resources {
+			flattened = append(flattened, map[string]interface{}{
+				"id":   resource.ID,
+				"name": resource.Name,
+			})
+		}
+		return flattened
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"load_balancers":   flatten(associated.LoadBalancers),
+			"volumes":          flatten(associated.Volumes),
+			"volume_snapshots": flatten(associated.VolumeSnapshots),
+		},
+	}
+}
+
+// kubernetesAssociatedResourceMatcher selects associated resources of one type
+// by name and tags.
+type kubernetesAssociatedResourceMatcher struct {
+	resourceType string
+	nameRegex    *regexp.Regexp
+	tags         []string
+}
+
+func (m *kubernetesAssociatedResourceMatcher) matches(resource *godo.AssociatedResource, tags []string) bool {
+	if m.nameRegex != nil && !m.nameRegex.MatchString(resource.Name) {
+		return false
+	}
+
+	if len(m.tags) == 0 {
+		return true
+	}
+
+	for _, t := range m.tags {
+		if slices.Contains(tags, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandKubernetesAssociatedResourceMatchers(raw []interface{}) ([]*kubernetesAssociatedResourceMatcher, error) {
+	matchers := make([]*kubernetesAssociatedResourceMatcher, 0, len(raw))
+	for _, r := range raw {
+		match := r.(map[string]interface{})
+		matcher := &kubernetesAssociatedResourceMatcher{
+			resourceType: match["type"].(string),
+		}
+
+		if nameRegex := match["name_regex"].(string); nameRegex != "" {
+			re, err := regexp.Compile(nameRegex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid name_regex %q: %s", nameRegex, err)
+			}
+			matcher.nameRegex = re
+		}
+
+		for _, t := range match["tags"].(*schema.Set).List() {
+			matcher.tags = append(matcher.tags, t.(string))
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	return matchers, nil
+}
+
+// expandKubernetesClusterDeleteSelectiveRequest builds the request for a
+// selective destroy from the destroy_associated_resources block. Resources are
+// selected by ID or by a matcher; IDs which the API does not report as
+// associated with the cluster are skipped with a warning.
+func expandKubernetesClusterDeleteSelectiveRequest(ctx context.Context, client *godo.Client, raw map[string]interface{}, associated *godo.KubernetesAssociatedResources) (*godo.KubernetesClusterDeleteSelectiveRequest, error) {
+	matchers, err := expandKubernetesAssociatedResourceMatchers(raw["match"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancers, err := selectKubernetesAssociatedResources(associatedLoadBalancerType, raw["load_balancer_ids"].(*schema.Set), matchers, associated.LoadBalancers,
+		func(id string) ([]string, error) {
+			lb, _, err := client.LoadBalancers.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return lb.Tags, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := selectKubernetesAssociatedResources(associatedVolumeType, raw["volume_ids"].(*schema.Set), matchers, associated.Volumes,
+		func(id string) ([]string, error) {
+			volume, _, err := client.Storage.GetVolume(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return volume.Tags, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSnapshots, err := selectKubernetesAssociatedResources(associatedVolumeSnapshotType, raw["volume_snapshot_ids"].(*schema.Set), matchers, associated.VolumeSnapshots,
+		func(id string) ([]string, error) {
+			snapshot, _, err := client.Storage.GetSnapshot(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return snapshot.Tags, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &godo.KubernetesClusterDeleteSelectiveRequest{
+		LoadBalancers:   loadBalancers,
+		Volumes:         volumes,
+		VolumeSnapshots: volumeSnapshots,
+	}, nil
+}
+
+func selectKubernetesAssociatedResources(
+	resourceType string,
+	requested *schema.Set,
+	matchers []*kubernetesAssociatedResourceMatcher,
+	associated []*godo.AssociatedResource,
+	getTags func(id string) ([]string, error),
+) ([]string, error) {
+	var typeMatchers []*kubernetesAssociatedResourceMatcher
+	needsTags := false
+	for _, m := range matchers {
+		if m.resourceType == resourceType {
+			typeMatchers = append(typeMatchers, m)
+			needsTags = needsTags || len(m.tags) > 0
+		}
+	}
+
+	available := make(map[string]bool, len(associated))
+	ids := []string{}
+	for _, resource := range associated {
+		available[resource.ID] = true
+
+		if requested.Contains(resource.ID) {
+			ids = append(ids, resource.ID)
+			continue
+		}
+
+		if len(typeMatchers) == 0 {
+			continue
+		}
+
+		var tags []string
+		if needsTags {
+			var err error
+			tags, err = getTags(resource.ID)
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving tags of %s (%s): %s", resourceType, resource.ID, err)
+			}
+		}
+
+		for _, m := range typeMatchers {
+			if m.matches(resource, tags) {
+				ids = append(ids, resource.ID)
+				break
+			}
+		}
+	}
+
+	for _, id := range requested.List() {
+		if !available[id.(string)] {
+			log.Printf("[WARN] %s (%s) is not associated with the cluster, skipping", resourceType, id)
+		}
+	}
+
+	return ids, nil
+}
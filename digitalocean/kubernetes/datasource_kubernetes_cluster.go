@@ -13,6 +13,7 @@ import (
 
 func DataSourceDigitalOceanKubernetesCluster() *schema.Resource {
 	dsNodePoolSchema := nodePoolSchema(false)
+	delete(dsNodePoolSchema, "replacement_strategy")
 
 	for _, k := range dsNodePoolSchema {
 		k.Computed = true
@@ -0,0 +1,65 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesClusterUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesClusterUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"version_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesClusterUpgradesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	upgrades, err := getKubernetesClusterUpgrades(context.Background(), client, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving available upgrades for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	validVersions := make([]string, 0)
+	for _, v := range upgrades {
+		if strings.HasPrefix(v, d.Get("version_prefix").(string)) {
+			validVersions = append(validVersions, v)
+		}
+	}
+	d.Set("valid_versions", validVersions)
+
+	latestVersion := ""
+	if len(validVersions) > 0 {
+		latestVersion = validVersions[0]
+	}
+	d.Set("latest_version", latestVersion)
+
+	return nil
+}
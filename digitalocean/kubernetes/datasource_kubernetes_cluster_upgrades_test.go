@@ -0,0 +1,74 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesClusterUpgrades_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionPrevious, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionPrevious, rName) + `
+data "digitalocean_kubernetes_cluster_upgrades" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "id",
+						"digitalocean_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "latest_version"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "valid_versions.0"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionPrevious, rName) + `
+data "digitalocean_kubernetes_cluster_upgrades" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foobar.id
+  version_prefix = "0.0."
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "valid_versions.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "latest_version", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanKubernetesClusterUpgrades_NotFound(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "digitalocean_kubernetes_cluster_upgrades" "foobar" {
+  cluster_id = "%s"
+}`, "00000000-0000-0000-0000-000000000000"),
+				ExpectError: regexp.MustCompile(`Error retrieving available upgrades for Kubernetes cluster`),
+			},
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// kubeconfigDefaultExpiry is how long the API keeps credentials valid when no
+// expiry is requested.
+const kubeconfigDefaultExpiry = 7 * 24 * time.Hour
+
+func DataSourceDigitalOceanKubernetesKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"expiry_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				Description:  "The duration in seconds that the returned credentials will be valid. If 0, the credentials will have a 7 day expiry",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"raw_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	expirySeconds := d.Get("expiry_seconds").(int)
+
+	requestedAt := time.Now().UTC()
+	kubeconfig, _, err := client.Kubernetes.GetKubeConfigWithExpiry(context.Background(), clusterID, int64(expirySeconds))
+	if err != nil {
+		return diag.Errorf("Error retrieving kubeconfig for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	cluster, user, err := parseKubeconfig(kubeconfig.KubeconfigYAML)
+	if err != nil {
+		return diag.Errorf("Error parsing kubeconfig for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	clientKey, err := base64.StdEncoding.DecodeString(user.ClientKeyData)
+	if err != nil {
+		return diag.Errorf("Error decoding client key for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	clientCertificate, err := base64.StdEncoding.DecodeString(user.ClientCertificateData)
+	if err != nil {
+		return diag.Errorf("Error decoding client certificate for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	expiry := kubeconfigDefaultExpiry
+	if expirySeconds > 0 {
+		expiry = time.Duration(expirySeconds) * time.Second
+	}
+	expiresAt := requestedAt.Add(expiry).Format(time.RFC3339)
+
+	d.SetId(clusterID)
+	d.Set("raw_config", string(kubeconfig.KubeconfigYAML))
+	d.Set("host", cluster.Server)
+	d.Set("cluster_ca_certificate", cluster.CertificateAuthorityData)
+	d.Set("token", user.Token)
+	d.Set("client_key", string(clientKey))
+	d.Set("client_certificate", string(clientCertificate))
+	d.Set("expires_at", expiresAt)
+
+	return nil
+}
+
+// parseKubeconfig returns the cluster and user of the current context of a
+// kubeconfig, falling back to the first ones if no current context is set.
+func parseKubeconfig(raw []byte) (*kubernetesConfigClusterData, *kubernetesConfigUserData, error) {
+	var kubeconfig kubernetesConfig
+	if err := yaml.Unmarshal(raw, &kubeconfig); err != nil {
+		return nil, nil, err
+	}
+
+	if len(kubeconfig.Clusters) == 0 || len(kubeconfig.Users) == 0 {
+		return nil, nil, fmt.Errorf("kubeconfig does not contain a cluster and a user")
+	}
+
+	clusterName, userName := kubeconfig.Clusters[0].Name, kubeconfig.Users[0].Name
+	for _, c := range kubeconfig.Contexts {
+		if c.Name == kubeconfig.CurrentContext {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			break
+		}
+	}
+
+	var cluster *kubernetesConfigClusterData
+	for i := range kubeconfig.Clusters {
+		if kubeconfig.Clusters[i].Name == clusterName {
+			cluster = &kubeconfig.Clusters[i].Cluster
+			break
+		}
+	}
+
+	var user *kubernetesConfigUserData
+	for i := range kubeconfig.Users {
+		if kubeconfig.Users[i].Name == userName {
+			user = &kubeconfig.Users[i].User
+			break
+		}
+	}
+
+	if cluster == nil || user == nil {
+		return nil, nil, fmt.Errorf("kubeconfig current context %q does not reference a known cluster and user", kubeconfig.CurrentContext)
+	}
+
+	return cluster, user, nil
+}
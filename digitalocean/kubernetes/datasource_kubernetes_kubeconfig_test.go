@@ -0,0 +1,60 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesKubeconfig_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName) + `
+data "digitalocean_kubernetes_kubeconfig" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foobar.id
+  expiry_seconds = 600
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_kubernetes_kubeconfig.foobar", "host",
+						"digitalocean_kubernetes_cluster.foobar", "endpoint"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_kubernetes_kubeconfig.foobar", "cluster_ca_certificate",
+						"digitalocean_kubernetes_cluster.foobar", "kube_config.0.cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_kubeconfig.foobar", "token"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_kubeconfig.foobar", "expires_at"),
+					resource.TestMatchResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "raw_config",
+						regexp.MustCompile(fmt.Sprintf("name: do-nyc1-%s", rName))),
+				),
+			},
+			{
+				// Without an expiry the API default of 7 days is reported.
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName) + `
+data "digitalocean_kubernetes_kubeconfig" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foobar.id
+  expiry_seconds = 0
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_kubeconfig.foobar", "expires_at"),
+				),
+			},
+		},
+	})
+}
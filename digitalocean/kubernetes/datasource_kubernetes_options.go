@@ -0,0 +1,17 @@
+package kubernetes
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanKubernetesOptions() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        kubernetesOptionsSchema(),
+		ResultAttributeName: "options",
+		FlattenRecord:       flattenDigitalOceanKubernetesOption,
+		GetRecords:          getDigitalOceanKubernetesOptions,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,70 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesOptions_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanKubernetesOptionsConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_options.foobar", "options.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_kubernetes_options.foobar", "options.*", map[string]string{
+						"type": "region",
+						"slug": "nyc1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.digitalocean_kubernetes_options.foobar", "options.*", map[string]string{
+						"type": "size",
+						"slug": "s-1vcpu-2gb",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanKubernetesOptions_FilterAndSort(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanKubernetesOptionsConfig_filtered,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_options.foobar", "options.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_options.foobar", "options.0.type", "region"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_options.foobar", "options.0.slug", "nyc1"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_options.foobar", "options.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanKubernetesOptionsConfig_basic = `
+data "digitalocean_kubernetes_options" "foobar" {}`
+
+const testAccCheckDataSourceDigitalOceanKubernetesOptionsConfig_filtered = `
+data "digitalocean_kubernetes_options" "foobar" {
+  filter {
+    key    = "type"
+    values = ["region"]
+  }
+
+  filter {
+    key    = "slug"
+    values = ["nyc1"]
+  }
+
+  sort {
+    key       = "slug"
+    direction = "asc"
+  }
+}`
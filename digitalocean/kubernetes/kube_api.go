@@ -0,0 +1,328 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// kubeAPIClient is a minimal client for the Kubernetes API of a cluster,
+// covering only what is needed to cordon and drain nodes.
+type kubeAPIClient struct {
+	host       string
+	token      string
+	httpClient *http.Client
+}
+
+type kubePod struct {
+	Metadata struct {
+		Name              string            `json:"name"`
+		Namespace         string            `json:"namespace"`
+		Annotations       map[string]string `json:"annotations"`
+		DeletionTimestamp *string           `json:"deletionTimestamp"`
+		OwnerReferences   []struct {
+			Kind string `json:"kind"`
+		} `json:"ownerReferences"`
+	} `json:"metadata"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+type kubePodList struct {
+	Items []kubePod `json:"items"`
+}
+
+type kubeNodeList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	} `json:"items"`
+}
+
+// newKubeAPIClient builds a client authenticated with the cluster's kubeconfig.
+func newKubeAPIClient(ctx context.Context, client *godo.Client, clusterID string) (*kubeAPIClient, error) {
+	kubeconfig, _, err := client.Kubernetes.GetKubeConfig(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving kubeconfig: %s", err)
+	}
+
+	cluster, user, err := parseKubeconfig(kubeconfig.KubeconfigYAML)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing kubeconfig: %s", err)
+	}
+
+	ca, err := base64.StdEncoding.DecodeString(cluster.CertificateAuthorityData)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding cluster CA certificate: %s", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("Error parsing cluster CA certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    roots,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if user.ClientCertificateData != "" && user.ClientKeyData != "" {
+		certificate, err := base64.StdEncoding.DecodeString(user.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding client certificate: %s", err)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(user.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding client key: %s", err)
+		}
+
+		keyPair, err := tls.X509KeyPair(certificate, key)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	return &kubeAPIClient{
+		host:  cluster.Server,
+		token: user.Token,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+func (c *kubeAPIClient) do(ctx context.Context, method, path, contentType string, body, v interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.host+path, reader)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, respBody)
+	}
+
+	if v != nil {
+		if err := json.Unmarshal(respBody, v); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// listNodeNames lists the names of the nodes matching a label selector.
+func (c *kubeAPIClient) listNodeNames(ctx context.Context, labelSelector string) ([]string, error) {
+	path := "/api/v1/nodes?labelSelector=" + url.QueryEscape(labelSelector)
+
+	list := new(kubeNodeList)
+	if _, err := c.do(ctx, http.MethodGet, path, "", nil, list); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(list.Items))
+	for _, node := range list.Items {
+		names = append(names, node.Metadata.Name)
+	}
+
+	return names, nil
+}
+
+// cordonNode marks a node as unschedulable.
+func (c *kubeAPIClient) cordonNode(ctx context.Context, name string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": true,
+		},
+	}
+
+	_, err := c.do(ctx, http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(name), "application/strategic-merge-patch+json", patch, nil)
+	return err
+}
+
+// uncordonNode marks a node as schedulable again.
+func (c *kubeAPIClient) uncordonNode(ctx context.Context, name string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": false,
+		},
+	}
+
+	_, err := c.do(ctx, http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(name), "application/strategic-merge-patch+json", patch, nil)
+	return err
+}
+
+// listEvictablePods lists the pods on a node which are evicted when it is
+// drained. Pods managed by a DaemonSet, mirror pods and completed pods are
+// skipped, as kubectl drain does.
+func (c *kubeAPIClient) listEvictablePods(ctx context.Context, nodeName string) ([]kubePod, error) {
+	path := "/api/v1/pods?fieldSelector=" + url.QueryEscape("spec.nodeName="+nodeName)
+
+	list := new(kubePodList)
+	if _, err := c.do(ctx, http.MethodGet, path, "", nil, list); err != nil {
+		return nil, err
+	}
+
+	var pods []kubePod
+	for _, pod := range list.Items {
+		if pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
+			continue
+		}
+
+		if _, ok := pod.Metadata.Annotations["kubernetes.io/config.mirror"]; ok {
+			continue
+		}
+
+		daemonSet := false
+		for _, owner := range pod.Metadata.OwnerReferences {
+			if owner.Kind == "DaemonSet" {
+				daemonSet = true
+			}
+		}
+		if daemonSet {
+			continue
+		}
+
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+// evictPod requests the eviction of a pod, returning the status code so that
+// evictions blocked by a PodDisruptionBudget can be retried.
+func (c *kubeAPIClient) evictPod(ctx context.Context, pod kubePod) (int, error) {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction",
+		url.PathEscape(pod.Metadata.Namespace), url.PathEscape(pod.Metadata.Name))
+
+	eviction := map[string]interface{}{
+		"apiVersion": "policy/v1",
+		"kind":       "Eviction",
+		"metadata": map[string]interface{}{
+			"name":      pod.Metadata.Name,
+			"namespace": pod.Metadata.Namespace,
+		},
+	}
+
+	return c.do(ctx, http.MethodPost, path, "application/json", eviction, nil)
+}
+
+// checkUnmanagedPods returns an error listing the pods on the nodes which are
+// not managed by a controller. Those pods would not be recreated elsewhere
+// once evicted, so like kubectl drain without --force, the drain is refused.
+func checkUnmanagedPods(ctx context.Context, api *kubeAPIClient, nodeNames []string) error {
+	var unmanaged []string
+	for _, name := range nodeNames {
+		pods, err := api.listEvictablePods(ctx, name)
+		if err != nil {
+			return fmt.Errorf("Error listing pods on node %s: %s", name, err)
+		}
+
+		for _, pod := range pods {
+			if len(pod.Metadata.OwnerReferences) == 0 {
+				unmanaged = append(unmanaged, pod.Metadata.Namespace+"/"+pod.Metadata.Name)
+			}
+		}
+	}
+
+	if len(unmanaged) > 0 {
+		return fmt.Errorf("the following pods are not managed by a controller and would be lost if evicted, delete or move them first: %s",
+			strings.Join(unmanaged, ", "))
+	}
+
+	return nil
+}
+
+// drainKubernetesNodes cordons the nodes, then evicts their pods and waits for
+// them to be gone. Evictions refused by a PodDisruptionBudget are retried
+// until the timeout. Nothing is done when pods not managed by a controller
+// are found on the nodes.
+func drainKubernetesNodes(ctx context.Context, api *kubeAPIClient, nodeNames []string, timeout time.Duration) error {
+	if err := checkUnmanagedPods(ctx, api, nodeNames); err != nil {
+		return err
+	}
+
+	for _, name := range nodeNames {
+		log.Printf("[INFO] Cordoning Kubernetes node %s", name)
+		if err := api.cordonNode(ctx, name); err != nil {
+			return fmt.Errorf("Error cordoning node %s: %s", name, err)
+		}
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		remaining := 0
+		for _, name := range nodeNames {
+			pods, err := api.listEvictablePods(ctx, name)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Error listing pods on node %s: %s", name, err))
+			}
+
+			for _, pod := range pods {
+				remaining++
+				if pod.Metadata.DeletionTimestamp != nil {
+					continue
+				}
+
+				if len(pod.Metadata.OwnerReferences) == 0 {
+					return retry.NonRetryableError(fmt.Errorf("pod %s/%s is not managed by a controller and would be lost if evicted", pod.Metadata.Namespace, pod.Metadata.Name))
+				}
+
+				status, err := api.evictPod(ctx, pod)
+				if err != nil && status != http.StatusNotFound && status != http.StatusTooManyRequests {
+					return retry.NonRetryableError(fmt.Errorf("Error evicting pod %s/%s: %s", pod.Metadata.Namespace, pod.Metadata.Name, err))
+				}
+			}
+		}
+
+		if remaining > 0 {
+			return retry.RetryableError(fmt.Errorf("%d pods are still running on the drained nodes", remaining))
+		}
+
+		return nil
+	})
+}
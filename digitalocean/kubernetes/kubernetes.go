@@ -9,6 +9,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	nodePoolReplacementReplace   = "replace"
+	nodePoolReplacementBlueGreen = "blue_green"
+)
+
 func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"id": {
@@ -21,13 +26,23 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			ValidateFunc: validation.NoZeroValues,
 		},
 
+		// Changing the size forces a new node pool unless the blue/green
+		// replacement strategy is used, see CustomizeDiff.
 		"size": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ForceNew:     true,
 			ValidateFunc: validation.NoZeroValues,
 		},
 
+		"replacement_strategy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				nodePoolReplacementReplace,
+				nodePoolReplacementBlueGreen,
+			}, false),
+		},
+
 		"actual_node_count": {
 			Type:     schema.TypeInt,
 			Computed: true,
@@ -462,6 +477,11 @@ func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.Kubern
 		rawPool["node_count"] = pool.Count
 	}
 
+	// The replacement strategy is only known from the configuration.
+	if v, ok := d.GetOk(keyPrefix + "replacement_strategy"); ok {
+		rawPool["replacement_strategy"] = v
+	}
+
 	return []interface{}{rawPool}
 }
 
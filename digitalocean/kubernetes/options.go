@@ -0,0 +1,170 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	kubernetesOptionVersion = "version"
+	kubernetesOptionRegion  = "region"
+	kubernetesOptionSize    = "size"
+)
+
+// kubernetesOption is a single version, region or node size returned by the
+// Kubernetes options endpoint.
+type kubernetesOption struct {
+	Type              string
+	Slug              string
+	Name              string
+	KubernetesVersion string
+	SupportedFeatures []string
+}
+
+func kubernetesOptionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Description: "The type of the option, one of version, region or size.",
+		},
+		"slug": {
+			Type:        schema.TypeString,
+			Description: "The slug identifying the version, region or node size.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The display name of the region or node size.",
+		},
+		"kubernetes_version": {
+			Type:        schema.TypeString,
+			Description: "The upstream Kubernetes version of the version option.",
+		},
+		"supported_features": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The features supported by the version option.",
+		},
+	}
+}
+
+func getDigitalOceanKubernetesOptions(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	options, _, err := client.Kubernetes.GetOptions(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Kubernetes options: %s", err)
+	}
+
+	var allOptions []interface{}
+	for _, v := range options.Versions {
+		allOptions = append(allOptions, kubernetesOption{
+			Type:              kubernetesOptionVersion,
+			Slug:              v.Slug,
+			KubernetesVersion: v.KubernetesVersion,
+			SupportedFeatures: v.SupportedFeatures,
+		})
+	}
+	for _, r := range options.Regions {
+		allOptions = append(allOptions, kubernetesOption{
+			Type: kubernetesOptionRegion,
+			Slug: r.Slug,
+			Name: r.Name,
+		})
+	}
+	for _, s := range options.Sizes {
+		allOptions = append(allOptions, kubernetesOption{
+			Type: kubernetesOptionSize,
+			Slug: s.Slug,
+			Name: s.Name,
+		})
+	}
+
+	return allOptions, nil
+}
+
+func flattenDigitalOceanKubernetesOption(rawOption, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	option, ok := rawOption.(kubernetesOption)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to kubernetesOption")
+	}
+
+	supportedFeatures := make([]interface{}, 0, len(option.SupportedFeatures))
+	for _, feature := range option.SupportedFeatures {
+		supportedFeatures = append(supportedFeatures, feature)
+	}
+
+	return map[string]interface{}{
+		"type":               option.Type,
+		"slug":               option.Slug,
+		"name":               option.Name,
+		"kubernetes_version": option.KubernetesVersion,
+		"supported_features": supportedFeatures,
+	}, nil
+}
+
+// validateKubernetesOptions checks a region and node size against the options
+// offered for Kubernetes clusters. Empty values are not checked.
+func validateKubernetesOptions(ctx context.Context, client *godo.Client, region, size string) error {
+	if region == "" && size == "" {
+		return nil
+	}
+
+	options, _, err := client.Kubernetes.GetOptions(ctx)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kubernetes options: %s", err)
+	}
+
+	if region != "" {
+		regions := make([]string, 0, len(options.Regions))
+		for _, r := range options.Regions {
+			regions = append(regions, r.Slug)
+		}
+		if !slices.Contains(regions, region) {
+			return fmt.Errorf("region %q is not available for Kubernetes clusters, must be one of: %s", region, strings.Join(regions, ", "))
+		}
+	}
+
+	if size != "" {
+		sizes := make([]string, 0, len(options.Sizes))
+		for _, s := range options.Sizes {
+			sizes = append(sizes, s.Slug)
+		}
+		if !slices.Contains(sizes, size) {
+			return fmt.Errorf("size %q is not available for Kubernetes node pools, must be one of: %s", size, strings.Join(sizes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateKubernetesClusterOptions checks the region and the size of the
+// default node pool during plan, so that an invalid value does not fail after
+// several minutes of apply.
+func validateKubernetesClusterOptions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var region, size string
+	if d.HasChange("region") && d.NewValueKnown("region") {
+		region = d.Get("region").(string)
+	}
+	if d.HasChange("node_pool.0.size") && d.NewValueKnown("node_pool.0.size") {
+		size = d.Get("node_pool.0.size").(string)
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	return validateKubernetesOptions(ctx, client, region, size)
+}
+
+// validateKubernetesNodePoolOptions checks the size of a node pool during plan.
+func validateKubernetesNodePoolOptions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("size") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	return validateKubernetesOptions(ctx, client, "", d.Get("size").(string))
+}
@@ -225,6 +225,10 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				Default:  false,
 			},
 
+			"destroy_associated_resources": kubernetesDestroyAssociatedResourcesSchema(),
+
+			"associated_resources": kubernetesAssociatedResourcesSchema(),
+
 			"kubeconfig_expire_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -331,6 +335,7 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -352,6 +357,16 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				}
 				return false
 			}),
+			validateKubernetesClusterUpgrade,
+			validateKubernetesClusterOptions,
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// Changing the size of the default node pool replaces the
+				// cluster, unless the pool uses the blue/green strategy.
+				if d.Id() != "" && d.HasChange("node_pool.0.size") && d.Get("node_pool.0.replacement_strategy").(string) != nodePoolReplacementBlueGreen {
+					return d.ForceNew("node_pool.0.size")
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -522,6 +537,15 @@ func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
 	}
 
+	// The associated resources are informational, so a failure to list them
+	// leaves the attribute unchanged rather than failing the refresh.
+	associated, _, err := client.Kubernetes.ListAssociatedResourcesForDeletion(ctx, d.Id())
+	if err != nil {
+		log.Printf("[WARN] Unable to retrieve associated resources of Kubernetes cluster (%s): %s", d.Id(), err)
+	} else if err := d.Set("associated_resources", flattenKubernetesAssociatedResources(associated)); err != nil {
+		return diag.Errorf("Error setting associated_resources: %s", err)
+	}
+
 	return digitaloceanKubernetesClusterRead(client, cluster, d)
 }
 
@@ -682,11 +706,26 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 			delete(newPool, "node_count")
 		}
 
-		// update the existing default pool
-		timeout := d.Timeout(schema.TimeoutCreate)
-		_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
-		if err != nil {
-			return diag.FromErr(err)
+		if d.HasChange("node_pool.0.size") {
+			// replace the default pool using the blue/green strategy
+			replaced := func(poolID string) {
+				// Record the new pool as soon as the old one is deleted, so
+				// that a later failure does not leave it out of the state.
+				newPool["id"] = poolID
+				d.Set("node_pool", []interface{}{newPool})
+			}
+
+			_, err := digitaloceanKubernetesNodePoolReplace(ctx, client, d.Timeout(schema.TimeoutUpdate), newPool, d.Id(), oldPool["id"].(string), replaced, DigitaloceanKubernetesDefaultNodePoolTag)
+			if err != nil {
+				return diag.Errorf("Error replacing default node pool: %s", err)
+			}
+		} else {
+			// update the existing default pool
+			timeout := d.Timeout(schema.TimeoutCreate)
+			_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -739,6 +778,33 @@ func resourceDigitalOceanKubernetesClusterDelete(ctx context.Context, d *schema.
 				return nil
 			}
 
+			return diag.Errorf("Unable to delete cluster: %s", err)
+		}
+	} else if v, ok := d.GetOk("destroy_associated_resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		associated, resp, err := client.Kubernetes.ListAssociatedResourcesForDeletion(ctx, d.Id())
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.Errorf("Failed to list associated resources: %s", err)
+		}
+
+		request, err := expandKubernetesClusterDeleteSelectiveRequest(ctx, client, v.([]interface{})[0].(map[string]interface{}), associated)
+		if err != nil {
+			return diag.Errorf("Unable to select associated resources: %s", err)
+		}
+
+		log.Printf("[WARN] The following resources will be destroyed: %s", godo.Stringify(request))
+
+		resp, err = client.Kubernetes.DeleteSelective(ctx, d.Id(), request)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
 			return diag.Errorf("Unable to delete cluster: %s", err)
 		}
 	} else {
@@ -873,6 +873,134 @@ func TestAccDigitalOceanKubernetesCluster_UpgradeVersion(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_DefaultNodePoolBlueGreen(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	clusterConfig := func(size string) string {
+		return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name                 = "default"
+    size                 = "%s"
+    node_count           = 1
+    replacement_strategy = "blue_green"
+  }
+}
+`, testClusterVersionLatest, rName, size)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig("s-1vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.replacement_strategy", "blue_green"),
+				),
+			},
+			{
+				Config: clusterConfig("s-2vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("digitalocean_kubernetes_cluster.foobar", "id", &k8s.ID),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.name", "default"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.size", "s-2vcpu-2gb"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "node_pool.0.replacement_strategy", "blue_green"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesCluster_InvalidUpgradeVersion(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionPrevious, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = "99.0.0-do.0"
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`version "99.0.0-do.0" is not a valid upgrade for Kubernetes cluster`),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesCluster_InvalidOptions(t *testing.T) {
+	rName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "xyz9"
+  version = "1.33.1-do.0"
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`region "xyz9" is not available for Kubernetes clusters`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = "1.33.1-do.0"
+
+  node_pool {
+    name       = "default"
+    size       = "s-0vcpu-0gb"
+    node_count = 1
+  }
+}
+`, rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`size "s-0vcpu-0gb" is not available for Kubernetes node pools`),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_DestroyAssociated(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -894,6 +1022,64 @@ func TestAccDigitalOceanKubernetesCluster_DestroyAssociated(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_DestroyAssociatedSelective(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	destroyAssociated := `
+  destroy_associated_resources {
+    match {
+      type = "load_balancer"
+    }
+
+    match {
+      type       = "volume_snapshot"
+      name_regex = "^backup-"
+    }
+  }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigDestroyAssociatedSelective(testClusterVersionLatest, rName, destroyAssociated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "destroy_associated_resources.0.match.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "associated_resources.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "associated_resources.0.load_balancers.#", "0"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "associated_resources.0.volumes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesCluster_DestroyAssociatedConflict(t *testing.T) {
+	rName := acceptance.RandomTestName()
+
+	destroyAssociated := `
+  destroy_all_associated_resources = true
+
+  destroy_associated_resources {
+    volume_ids = ["9c9e2e3a-4f1d-4f0e-8f8e-3d5e4c6b7a81"]
+  }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDigitalOceanKubernetesConfigDestroyAssociatedSelective(testClusterVersionLatest, rName, destroyAssociated),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"destroy_associated_resources": conflicts with destroy_all_associated_resources`),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_VPCNative(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -1258,6 +1444,24 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 `, testClusterVersion, rName)
 }
 
+func testAccDigitalOceanKubernetesConfigDestroyAssociatedSelective(testClusterVersion string, rName string, destroyAssociated string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+%s
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName, destroyAssociated)
+}
+
 func testAccDigitalOceanKubernetesConfigVPCNative(testClusterVersion string, rName string) string {
 	return fmt.Sprintf(`%s
 
@@ -3,14 +3,17 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,8 +37,31 @@ func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// Changing the size replaces the node pool, in place when the
+				// blue/green strategy is used.
+				if d.Id() == "" || !d.HasChange("size") {
+					return nil
+				}
+
+				if d.Get("replacement_strategy").(string) != nodePoolReplacementBlueGreen {
+					return d.ForceNew("size")
+				}
+
+				for _, key := range []string{"id", "nodes", "actual_node_count"} {
+					if err := d.SetNewComputed(key); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			validateKubernetesNodePoolOptions,
+		),
 	}
 }
 
@@ -102,6 +128,32 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	if d.HasChange("size") {
+		rawPool := map[string]interface{}{
+			"name":       d.Get("name"),
+			"size":       d.Get("size"),
+			"tags":       d.Get("tags"),
+			"labels":     d.Get("labels"),
+			"auto_scale": d.Get("auto_scale"),
+			"min_nodes":  d.Get("min_nodes"),
+			"max_nodes":  d.Get("max_nodes"),
+			"taint":      d.Get("taint"),
+		}
+
+		if _, ok := d.GetOk("node_count"); ok {
+			rawPool["node_count"] = d.Get("node_count")
+		}
+
+		pool, err := digitaloceanKubernetesNodePoolReplace(ctx, client, d.Timeout(schema.TimeoutUpdate), rawPool, d.Get("cluster_id").(string), d.Id(), d.SetId)
+		if err != nil {
+			return diag.Errorf("Error replacing node pool: %s", err)
+		}
+
+		d.SetId(pool.ID)
+
+		return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+	}
+
 	rawPool := map[string]interface{}{
 		"name": d.Get("name"),
 		"tags": d.Get("tags"),
@@ -287,6 +339,98 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 	return p, nil
 }
 
+// digitaloceanKubernetesNodePoolReplace replaces a node pool using the
+// blue/green strategy: a new pool is created and becomes ready, the nodes of
+// the old pool are cordoned and drained, and the old pool is deleted. The new
+// pool is created under a temporary name, as pool names must be unique within
+// a cluster, and renamed once the old pool is gone.
+//
+// If the old pool can not be drained, the new pool is deleted and the old
+// nodes uncordoned, leaving the old pool in place. Once the old pool is
+// deleted, replaced is called with the ID of the new pool so that the caller
+// can record it in the state before anything else can fail.
+func digitaloceanKubernetesNodePoolReplace(ctx context.Context, client *godo.Client, timeout time.Duration, pool map[string]interface{}, clusterID, oldPoolID string, replaced func(poolID string), customTags ...string) (*godo.KubernetesNodePool, error) {
+	oldPool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, oldPoolID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving node pool to replace: %s", err)
+	}
+
+	newPool := make(map[string]interface{}, len(pool))
+	for k, v := range pool {
+		newPool[k] = v
+	}
+
+	name := pool["name"].(string)
+	newPool["name"] = fmt.Sprintf("%s-%s", name, strconv.FormatInt(time.Now().Unix(), 36))
+	if _, ok := newPool["node_count"]; !ok {
+		newPool["node_count"] = oldPool.Count
+	}
+
+	log.Printf("[INFO] Creating node pool %s to replace node pool %s", newPool["name"], oldPoolID)
+	created, err := digitaloceanKubernetesNodePoolCreate(client, timeout, newPool, clusterID, customTags...)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeNames := make([]string, 0, len(oldPool.Nodes))
+	for _, node := range oldPool.Nodes {
+		nodeNames = append(nodeNames, node.Name)
+	}
+
+	api, err := newKubeAPIClient(ctx, client, clusterID)
+	if err != nil {
+		err = fmt.Errorf("Unable to drain node pool %s: %s", oldPoolID, err)
+		return nil, rollbackKubernetesNodePoolReplace(ctx, client, nil, nil, clusterID, created.ID, err)
+	}
+
+	if err := drainKubernetesNodes(ctx, api, nodeNames, timeout); err != nil {
+		err = fmt.Errorf("Unable to drain node pool %s: %s", oldPoolID, err)
+		return nil, rollbackKubernetesNodePoolReplace(ctx, client, api, nodeNames, clusterID, created.ID, err)
+	}
+
+	log.Printf("[INFO] Deleting replaced node pool %s", oldPoolID)
+	if _, err := client.Kubernetes.DeleteNodePool(ctx, clusterID, oldPoolID); err != nil {
+		err = fmt.Errorf("Unable to delete replaced node pool %s: %s", oldPoolID, err)
+		return nil, rollbackKubernetesNodePoolReplace(ctx, client, api, nodeNames, clusterID, created.ID, err)
+	}
+
+	replaced(created.ID)
+
+	if err := waitForKubernetesNodePoolDeleted(client, timeout, clusterID, oldPoolID); err != nil {
+		return created, err
+	}
+
+	newPool["name"] = name
+	updated, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, clusterID, created.ID, customTags...)
+	if err != nil {
+		return created, fmt.Errorf("Unable to rename replacement node pool %s to %s: %s", created.ID, name, err)
+	}
+
+	return updated, nil
+}
+
+// rollbackKubernetesNodePoolReplace undoes a blue/green replacement which
+// failed before the old pool was deleted: the old nodes are uncordoned and the
+// replacement pool is deleted. Failures are added to the original error so
+// that nothing is left behind unnoticed.
+func rollbackKubernetesNodePoolReplace(ctx context.Context, client *godo.Client, api *kubeAPIClient, nodeNames []string, clusterID, newPoolID string, err error) error {
+	if api != nil {
+		for _, name := range nodeNames {
+			log.Printf("[INFO] Uncordoning Kubernetes node %s", name)
+			if uncordonErr := api.uncordonNode(ctx, name); uncordonErr != nil {
+				err = fmt.Errorf("%s; unable to uncordon node %s: %s", err, name, uncordonErr)
+			}
+		}
+	}
+
+	log.Printf("[INFO] Deleting replacement node pool %s", newPoolID)
+	if _, deleteErr := client.Kubernetes.DeleteNodePool(ctx, clusterID, newPoolID); deleteErr != nil {
+		return fmt.Errorf("%s; unable to delete replacement node pool %s, it must be removed manually: %s", err, newPoolID, deleteErr)
+	}
+
+	return err
+}
+
 func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration, id string, poolID string) error {
 	var (
 		tickerInterval = 10 * time.Second
@@ -327,16 +471,20 @@ func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration
 }
 
 func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData) error {
+	return waitForKubernetesNodePoolDeleted(client, d.Timeout(schema.TimeoutDelete), d.Get("cluster_id").(string), d.Id())
+}
+
+func waitForKubernetesNodePoolDeleted(client *godo.Client, duration time.Duration, clusterID string, poolID string) error {
 	var (
 		tickerInterval = 10 * time.Second
-		timeoutSeconds = d.Timeout(schema.TimeoutDelete).Seconds()
+		timeoutSeconds = duration.Seconds()
 		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
 		n              = 0
 		ticker         = time.NewTicker(tickerInterval)
 	)
 
 	for range ticker.C {
-		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), clusterID, poolID)
 		if err != nil {
 			ticker.Stop()
 
@@ -3,6 +3,8 @@ package kubernetes_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -92,6 +94,82 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 	})
 }
 
+func TestAccDigitalOceanKubernetesNodePool_BlueGreenReplacement(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var k8sPool godo.KubernetesNodePool
+
+	clusterConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName)
+
+	nodePoolConfig := func(size string) string {
+		return fmt.Sprintf(`resource digitalocean_kubernetes_node_pool "barfoo" {
+  cluster_id           = digitalocean_kubernetes_cluster.foobar.id
+  name                 = "%s"
+  size                 = "%s"
+  node_count           = 1
+  replacement_strategy = "blue_green"
+}
+`, rName, size)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig + nodePoolConfig("s-1vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "replacement_strategy", "blue_green"),
+				),
+			},
+			{
+				Config: clusterConfig + nodePoolConfig("s-2vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesNodePoolReplaced(&k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "size", "s-2vcpu-2gb"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "actual_node_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesNodePool_InvalidSize(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_kubernetes_node_pool" "barfoo" {
+  cluster_id = "a4ad1b73-b0ad-4cf5-98a2-2b1e0a9bc4b5"
+  name       = "invalid-size"
+  size       = "s-0vcpu-0gb"
+  node_count = 1
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`size "s-0vcpu-0gb" is not available for Kubernetes node pools`),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesNodePool_MinNodesZero(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -641,6 +719,22 @@ resource digitalocean_kubernetes_node_pool "barfoo" {
 `, testClusterVersionLatest, rName, rName)
 }
 
+func testAccCheckDigitalOceanKubernetesNodePoolReplaced(cluster *godo.KubernetesCluster, pool *godo.KubernetesNodePool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), cluster.ID, pool.ID)
+		if err == nil {
+			return fmt.Errorf("Replaced node pool %s still exists", pool.ID)
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDigitalOceanKubernetesNodePoolExists(n string, cluster *godo.KubernetesCluster, pool *godo.KubernetesNodePool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,272 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanKubernetesNodeRecycle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesNodeRecycleCreate,
+		ReadContext:   resourceDigitalOceanKubernetesNodeRecycleRead,
+		DeleteContext: resourceDigitalOceanKubernetesNodeRecycleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the Kubernetes cluster",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"node_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the node pool of the nodes",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"node_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The IDs of the nodes to recycle",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"node_ids", "label_selector"},
+			},
+			"label_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "A Kubernetes label selector matching the nodes of the pool to recycle",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"node_ids", "label_selector"},
+			},
+			"replace": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether to replace the nodes with new ones. If false, the nodes are deleted and the pool shrinks",
+			},
+			"skip_drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to skip draining the nodes before deleting them",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary values that, when changed, cause the nodes to be recycled again",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"recycled_node_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the nodes which were recycled",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesNodeRecycleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	poolID := d.Get("node_pool_id").(string)
+
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+	}
+
+	nodeIDs, err := selectKubernetesNodesToRecycle(ctx, client, d, pool)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &godo.KubernetesNodeDeleteRequest{
+		Replace:   d.Get("replace").(bool),
+		SkipDrain: d.Get("skip_drain").(bool),
+	}
+
+	d.SetId(id.PrefixedUniqueId(poolID + "-"))
+
+	// Nodes are recycled one at a time, waiting for the pool to be back to
+	// the running nodes it had before each deletion, so that capacity is
+	// never reduced by more than one node.
+	recycled := make([]string, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		before, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			d.Set("recycled_node_ids", recycled)
+			return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+		}
+
+		log.Printf("[INFO] Recycling node %s of Kubernetes node pool %s", nodeID, poolID)
+
+		if _, err := client.Kubernetes.DeleteNode(ctx, clusterID, poolID, nodeID, req); err != nil {
+			d.Set("recycled_node_ids", recycled)
+			return diag.Errorf("Error deleting node %s: %s", nodeID, err)
+		}
+
+		if err := waitForKubernetesNodeRecycled(ctx, client, before, clusterID, nodeID, req.Replace, d.Timeout(schema.TimeoutCreate)); err != nil {
+			d.Set("recycled_node_ids", recycled)
+			return diag.Errorf("Error waiting for node %s to be recycled: %s", nodeID, err)
+		}
+
+		recycled = append(recycled, nodeID)
+	}
+
+	if err := d.Set("recycled_node_ids", recycled); err != nil {
+		return diag.Errorf("Error setting recycled_node_ids: %s", err)
+	}
+
+	return nil
+}
+
+// selectKubernetesNodesToRecycle returns the IDs of the nodes of the pool
+// selected by node_ids or label_selector.
+func selectKubernetesNodesToRecycle(ctx context.Context, client *godo.Client, d *schema.ResourceData, pool *godo.KubernetesNodePool) ([]string, error) {
+	if v, ok := d.GetOk("node_ids"); ok {
+		var nodeIDs []string
+		for _, nodeID := range v.(*schema.Set).List() {
+			found := false
+			for _, node := range pool.Nodes {
+				if node.ID == nodeID.(string) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("node %s is not part of node pool %s", nodeID, pool.ID)
+			}
+			nodeIDs = append(nodeIDs, nodeID.(string))
+		}
+		return nodeIDs, nil
+	}
+
+	api, err := newKubeAPIClient(ctx, client, d.Get("cluster_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	labelSelector := d.Get("label_selector").(string)
+	names, err := api.listNodeNames(ctx, labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing nodes matching %q: %s", labelSelector, err)
+	}
+
+	var nodeIDs []string
+	for _, node := range pool.Nodes {
+		if slices.Contains(names, node.Name) {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+	}
+
+	if len(nodeIDs) == 0 {
+		return nil, fmt.Errorf("no node of node pool %s matches %q", pool.ID, labelSelector)
+	}
+
+	return nodeIDs, nil
+}
+
+// waitForKubernetesNodeRecycled waits for a deleted node to be gone from the
+// pool and, when it is replaced, for a new node to be running.
+func waitForKubernetesNodeRecycled(ctx context.Context, client *godo.Client, before *godo.KubernetesNodePool, clusterID, nodeID string, replace bool, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"recycling"},
+		Target:  []string{"recycled"},
+		Refresh: func() (interface{}, string, error) {
+			pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, before.ID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return pool, KubernetesNodeRecycleState(before, pool, nodeID, replace), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// KubernetesNodeRecycleState returns "recycled" once the deleted node is gone
+// from the pool and as many nodes are running as before the deletion, or one
+// less when the node is not replaced. When the node is replaced, a running
+// node which was not part of the pool before is also required. Otherwise it
+// returns "recycling". The count is taken from the nodes running before the
+// deletion rather than the size of the pool, so nodes which are provisioning
+// or have failed, or an autoscaled pool, do not keep it waiting.
+func KubernetesNodeRecycleState(before, current *godo.KubernetesNodePool, nodeID string, replace bool) string {
+	existing := make(map[string]bool, len(before.Nodes))
+	expected := 0
+	for _, node := range before.Nodes {
+		existing[node.ID] = true
+		if !kubernetesNodeRunning(node) {
+			continue
+		}
+		if node.ID != nodeID || replace {
+			expected++
+		}
+	}
+
+	running := 0
+	replaced := false
+	for _, node := range current.Nodes {
+		if node.ID == nodeID {
+			return "recycling"
+		}
+		if !kubernetesNodeRunning(node) {
+			continue
+		}
+		running++
+		if !existing[node.ID] {
+			replaced = true
+		}
+	}
+
+	if running < expected || (replace && !replaced) {
+		return "recycling"
+	}
+
+	return "recycled"
+}
+
+func kubernetesNodeRunning(node *godo.KubernetesNode) bool {
+	return node.Status != nil && node.Status.State == "running"
+}
+
+func resourceDigitalOceanKubernetesNodeRecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The recycled nodes no longer exist, so there is nothing to refresh.
+	return nil
+}
+
+func resourceDigitalOceanKubernetesNodeRecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Recycling nodes can not be undone, removing the resource only removes
+	// it from the state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,177 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanKubernetesNodeRecycle_NodeIDs(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	recycleConfig := `
+resource "digitalocean_kubernetes_node_recycle" "foobar" {
+  cluster_id   = digitalocean_kubernetes_cluster.foobar.id
+  node_pool_id = digitalocean_kubernetes_cluster.foobar.node_pool[0].id
+  node_ids     = [digitalocean_kubernetes_cluster.foobar.node_pool[0].nodes[0].id]
+
+  triggers = {
+    cve = "CVE-2024-1086"
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName) + recycleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "recycled_node_ids.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "replace", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesNodeRecycle_LabelSelector(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	recycleConfig := `
+resource "digitalocean_kubernetes_node_recycle" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foobar.id
+  node_pool_id   = digitalocean_kubernetes_cluster.foobar.node_pool[0].id
+  label_selector = "priority=high"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName) + recycleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_recycle.foobar", "recycled_node_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesNodeRecycle_InvalidArguments(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_kubernetes_node_recycle" "foobar" {
+  cluster_id     = "%s"
+  node_pool_id   = "%s"
+  node_ids       = ["node-1"]
+  label_selector = "priority=high"
+}`, "a4ad1b73-b0ad-4cf5-98a2-2b1e0a9bc4b5", "cdda885e-7663-40c8-bc74-3a036c66545d"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only one of .label_selector,node_ids. can be specified`),
+			},
+		},
+	})
+}
+
+func Test_kubernetesNodeRecycleState(t *testing.T) {
+	node := func(id, state string) *godo.KubernetesNode {
+		return &godo.KubernetesNode{ID: id, Status: &godo.KubernetesNodeStatus{State: state}}
+	}
+	pool := func(nodes ...*godo.KubernetesNode) *godo.KubernetesNodePool {
+		return &godo.KubernetesNodePool{ID: "pool", Nodes: nodes}
+	}
+
+	tests := []struct {
+		name    string
+		before  *godo.KubernetesNodePool
+		current *godo.KubernetesNodePool
+		replace bool
+		want    string
+	}{
+		{
+			name:    "deleted node still present",
+			before:  pool(node("a", "running"), node("b", "running")),
+			current: pool(node("a", "deleting"), node("b", "running"), node("c", "running")),
+			replace: true,
+			want:    "recycling",
+		},
+		{
+			name:    "replacement provisioning",
+			before:  pool(node("a", "running"), node("b", "running")),
+			current: pool(node("b", "running"), node("c", "provisioning")),
+			replace: true,
+			want:    "recycling",
+		},
+		{
+			name:    "replacement running",
+			before:  pool(node("a", "running"), node("b", "running")),
+			current: pool(node("b", "running"), node("c", "running")),
+			replace: true,
+			want:    "recycled",
+		},
+		{
+			name:    "failed and provisioning nodes are ignored",
+			before:  pool(node("a", "running"), node("b", "running"), node("f", "failed"), node("p", "provisioning")),
+			current: pool(node("b", "running"), node("c", "running"), node("f", "failed"), node("p", "provisioning")),
+			replace: true,
+			want:    "recycled",
+		},
+		{
+			name:    "other running node lost",
+			before:  pool(node("a", "running"), node("b", "running"), node("d", "running")),
+			current: pool(node("b", "running"), node("c", "running")),
+			replace: true,
+			want:    "recycling",
+		},
+		{
+			name:    "pool shrinks without replacement",
+			before:  pool(node("a", "running"), node("b", "running")),
+			current: pool(node("b", "running")),
+			replace: false,
+			want:    "recycled",
+		},
+		{
+			name:    "autoscaler added a node without replacement",
+			before:  pool(node("a", "running"), node("b", "running")),
+			current: pool(node("b", "running"), node("c", "running")),
+			replace: false,
+			want:    "recycled",
+		},
+	}
+
+	for _, tt := range tests {
+		got := kubernetes.KubernetesNodeRecycleState(tt.before, tt.current, "a", tt.replace)
+		if got != tt.want {
+			t.Errorf("%s: KubernetesNodeRecycleState returned %q, expected %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanKubernetesOneClick() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesOneClickCreate,
+		ReadContext:   resourceDigitalOceanKubernetesOneClickRead,
+		UpdateContext: resourceDigitalOceanKubernetesOneClickUpdate,
+		DeleteContext: resourceDigitalOceanKubernetesOneClickDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the Kubernetes cluster to install the 1-Click applications on",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"addon_slugs": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The slugs of the Kubernetes 1-Click applications to install",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The message returned by the API for the last installation",
+			},
+		},
+
+		CustomizeDiff: validateKubernetesOneClickSlugs,
+	}
+}
+
+func resourceDigitalOceanKubernetesOneClickCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_uuid").(string)
+	slugs := expandKubernetesOneClickSlugs(d.Get("addon_slugs").(*schema.Set).List())
+
+	message, err := installKubernetesOneClicks(ctx, client, clusterID, slugs)
+	if err != nil {
+		return diag.Errorf("Error installing 1-Click applications on Kubernetes cluster: %s", err)
+	}
+
+	d.SetId(id.PrefixedUniqueId(clusterID + "-"))
+	d.Set("message", message)
+
+	return nil
+}
+
+func resourceDigitalOceanKubernetesOneClickUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if !d.HasChange("addon_slugs") {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	old, new := d.GetChange("addon_slugs")
+	added := expandKubernetesOneClickSlugs(new.(*schema.Set).Difference(old.(*schema.Set)).List())
+	removed := expandKubernetesOneClickSlugs(old.(*schema.Set).Difference(new.(*schema.Set)).List())
+
+	if len(added) > 0 {
+		message, err := installKubernetesOneClicks(ctx, client, d.Get("cluster_uuid").(string), added)
+		if err != nil {
+			// Keep the previous addon_slugs in state so the failed
+			// installation is retried on the next apply.
+			d.Partial(true)
+			return diag.Errorf("Error installing 1-Click applications on Kubernetes cluster: %s", err)
+		}
+		d.Set("message", message)
+	}
+
+	if len(removed) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "1-Click applications were not uninstalled",
+			Detail: fmt.Sprintf("1-Click applications can not be uninstalled through the API, "+
+				"the following remain installed on the cluster: %s", strings.Join(removed, ", ")),
+		})
+	}
+
+	return diags
+}
+
+func installKubernetesOneClicks(ctx context.Context, client *godo.Client, clusterID string, slugs []string) (string, error) {
+	req := &godo.InstallKubernetesAppsRequest{
+		ClusterUUID: clusterID,
+		Slugs:       slugs,
+	}
+
+	log.Printf("[DEBUG] Installing 1-Click applications %v on Kubernetes cluster %s", slugs, clusterID)
+	resp, _, err := client.OneClick.InstallKubernetes(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Message, nil
+}
+
+// validateKubernetesOneClickSlugs checks the requested slugs against the
+// Kubernetes 1-Click applications offered by the API, so that typos are
+// reported at plan time rather than by a failed installation.
+func validateKubernetesOneClickSlugs(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("addon_slugs") || !d.HasChange("addon_slugs") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	oneClicks, _, err := client.OneClick.List(ctx, "kubernetes")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kubernetes 1-Click applications: %s", err)
+	}
+
+	available := make([]string, 0, len(oneClicks))
+	for _, oneClick := range oneClicks {
+		available = append(available, oneClick.Slug)
+	}
+
+	for _, slug := range expandKubernetesOneClickSlugs(d.Get("addon_slugs").(*schema.Set).List()) {
+		if !slices.Contains(available, slug) {
+			return fmt.Errorf("%q is not a valid Kubernetes 1-Click application slug", slug)
+		}
+	}
+
+	return nil
+}
+
+func expandKubernetesOneClickSlugs(raw []interface{}) []string {
+	slugs := make([]string, 0, len(raw))
+	for _, slug := range raw {
+		slugs = append(slugs, slug.(string))
+	}
+	slices.Sort(slugs)
+
+	return slugs
+}
+
+func resourceDigitalOceanKubernetesOneClickRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API does not report which 1-Click applications are installed on a
+	// cluster, so there is nothing to refresh.
+	return nil
+}
+
+func resourceDigitalOceanKubernetesOneClickDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// 1-Click applications can not be uninstalled through the API, removing
+	// the resource only removes it from the state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+package kubernetes_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanKubernetesOneClick_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	oneClickConfig := `
+resource "digitalocean_kubernetes_one_click" "foobar" {
+  cluster_uuid = digitalocean_kubernetes_cluster.foobar.id
+  addon_slugs  = ["monitoring"]
+}
+`
+
+	oneClickConfigUpdated := `
+resource "digitalocean_kubernetes_one_click" "foobar" {
+  cluster_uuid = digitalocean_kubernetes_cluster.foobar.id
+  addon_slugs  = ["monitoring", "ingress-nginx"]
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName) + oneClickConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_kubernetes_one_click.foobar", "cluster_uuid",
+						"digitalocean_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_one_click.foobar", "addon_slugs.#", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_one_click.foobar", "message"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanKubernetesConfigBasic(testClusterVersionLatest, rName) + oneClickConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_one_click.foobar", "addon_slugs.#", "2"),
+					resource.TestCheckTypeSetElemAttr("digitalocean_kubernetes_one_click.foobar", "addon_slugs.*", "ingress-nginx"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesOneClick_InvalidSlug(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_kubernetes_one_click" "foobar" {
+  cluster_uuid = "a4ad1b73-b0ad-4cf5-98a2-2b1e0a9bc4b5"
+  addon_slugs  = ["not-a-real-one-click"]
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"not-a-real-one-click" is not a valid Kubernetes 1-Click application slug`),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getKubernetesClusterUpgrades returns the slugs of the versions a cluster can
+// be upgraded to, newest first.
+func getKubernetesClusterUpgrades(ctx context.Context, client *godo.Client, clusterID string) ([]string, error) {
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	slugs := make([]string, 0, len(upgrades))
+	for _, upgrade := range upgrades {
+		slugs = append(slugs, upgrade.Slug)
+	}
+
+	sort.SliceStable(slugs, func(i, j int) bool {
+		vi, erri := version.NewVersion(slugs[i])
+		vj, errj := version.NewVersion(slugs[j])
+		if erri != nil || errj != nil {
+			return false
+		}
+		return vi.GreaterThan(vj)
+	})
+
+	return slugs, nil
+}
+
+// validateKubernetesClusterUpgrade checks a change of version against the
+// upgrades available for the cluster, so that an invalid upgrade fails during
+// plan rather than apply. Downgrades are left to the ForceNew on version.
+func validateKubernetesClusterUpgrade(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("version") || !d.NewValueKnown("version") {
+		return nil
+	}
+
+	o, n := d.GetChange("version")
+	oldSlug, newSlug := o.(string), n.(string)
+
+	oldVer, oldErr := version.NewVersion(oldSlug)
+	newVer, newErr := version.NewVersion(newSlug)
+	if oldErr == nil && newErr == nil && newVer.LessThan(oldVer) {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	upgrades, err := getKubernetesClusterUpgrades(ctx, client, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving available upgrades for Kubernetes cluster (%s): %s", d.Id(), err)
+	}
+
+	for _, upgrade := range upgrades {
+		if upgrade == newSlug {
+			return nil
+		}
+	}
+
+	if len(upgrades) == 0 {
+		return fmt.Errorf("version %q is not a valid upgrade for Kubernetes cluster running %s: no upgrades are available", newSlug, oldSlug)
+	}
+
+	return fmt.Errorf("version %q is not a valid upgrade for Kubernetes cluster running %s, must be one of: %s",
+		newSlug, oldSlug, strings.Join(upgrades, ", "))
+}
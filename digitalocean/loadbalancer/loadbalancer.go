@@ -384,3 +384,52 @@ func flattenLoadBalancerIds(list []string) *schema.Set {
 	}
 	return flatSet
 }
+
+// loadbalancerMutexKey returns the key used to serialize changes to the
+// forwarding rules of a load balancer.
+func loadbalancerMutexKey(id string) string {
+	return fmt.Sprintf("resource_digitalocean_loadbalancer/%s", id)
+}
+
+// forwardingRuleKey identifies a forwarding rule on a load balancer, which can
+// only have one rule per entry protocol and port.
+func forwardingRuleKey(entryProtocol string, entryPort int) string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(entryProtocol), entryPort)
+}
+
+func findForwardingRule(rules []godo.ForwardingRule, entryProtocol string, entryPort int) *godo.ForwardingRule {
+	key := forwardingRuleKey(entryProtocol, entryPort)
+	for i := range rules {
+		if forwardingRuleKey(rules[i].EntryProtocol, rules[i].EntryPort) == key {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
+
+// forwardingRuleKeys returns the keys of the forwarding rules in a
+// forwarding_rule set.
+func forwardingRuleKeys(rules []interface{}) map[string]bool {
+	keys := make(map[string]bool, len(rules))
+	for _, rawRule := range rules {
+		rule := rawRule.(map[string]interface{})
+		keys[forwardingRuleKey(rule["entry_protocol"].(string), rule["entry_port"].(int))] = true
+	}
+
+	return keys
+}
+
+// filterForwardingRules splits the forwarding rules of a load balancer into
+// those whose key is in keys and the rest.
+func filterForwardingRules(rules []godo.ForwardingRule, keys map[string]bool) (matched []godo.ForwardingRule, other []godo.ForwardingRule) {
+	for _, rule := range rules {
+		if keys[forwardingRuleKey(rule.EntryProtocol, rule.EntryPort)] {
+			matched = append(matched, rule)
+		} else {
+			other = append(other, rule)
+		}
+	}
+
+	return matched, other
+}
@@ -13,12 +13,15 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var mutexKV = mutexkv.NewMutexKV()
+
 func ResourceDigitalOceanLoadbalancer() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanLoadbalancerCreate,
@@ -111,6 +114,12 @@ func resourceDigitalOceanLoadBalancerV1() map[string]*schema.Schema {
 
 	maps.Copy(loadBalancerV1Schema, loadBalancerV0Schema)
 	loadBalancerV1Schema["forwarding_rule"].Elem.(*schema.Resource).Schema = forwardingRuleSchema
+	loadBalancerV1Schema["ignore_external_forwarding_rules"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "whether forwarding rules not declared in forwarding_rule, such as those managed by digitalocean_loadbalancer_forwarding_rule, are left in place",
+	}
 
 	return loadBalancerV1Schema
 }
@@ -801,7 +810,14 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("Error setting  load balancer healthcheck: %#v", err)
 	}
 
-	forwardingRules, err := flattenForwardingRules(client, loadbalancer.ForwardingRules)
+	rules := loadbalancer.ForwardingRules
+	if d.Get("ignore_external_forwarding_rules").(bool) {
+		// Only the rules declared on this resource are tracked, rules
+		// managed elsewhere are left out of the state.
+		rules, _ = filterForwardingRules(rules, forwardingRuleKeys(d.Get("forwarding_rule").(*schema.Set).List()))
+	}
+
+	forwardingRules, err := flattenForwardingRules(client, rules)
 	if err != nil {
 		return diag.Errorf("Error building  load balancer forwarding rules: %#v", err)
 	}
@@ -842,6 +858,33 @@ func resourceDigitalOceanLoadbalancerUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	key := loadbalancerMutexKey(d.Id())
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	if d.Get("ignore_external_forwarding_rules").(bool) {
+		loadbalancer, _, err := client.LoadBalancers.Get(context.Background(), d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving Loadbalancer: %s", err)
+		}
+
+		// The update replaces all forwarding rules, so the rules not
+		// previously declared on this resource are sent along unchanged.
+		// When the flag is being enabled, e.g. after an import which
+		// read every rule into the state, all the rules no longer
+		// declared are kept.
+		declared, _ := d.GetChange("forwarding_rule")
+		if d.HasChange("ignore_external_forwarding_rules") {
+			declared = d.Get("forwarding_rule")
+		}
+		_, external := filterForwardingRules(loadbalancer.ForwardingRules, forwardingRuleKeys(declared.(*schema.Set).List()))
+		for _, rule := range external {
+			if findForwardingRule(lbOpts.ForwardingRules, rule.EntryProtocol, rule.EntryPort) == nil {
+				lbOpts.ForwardingRules = append(lbOpts.ForwardingRules, rule)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Load Balancer Update: %#v", lbOpts)
 	_, _, err = client.LoadBalancers.Update(context.Background(), d.Id(), lbOpts)
 	if err != nil {
@@ -0,0 +1,282 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanLoadbalancerForwardingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanLoadbalancerForwardingRuleCreate,
+		ReadContext:   resourceDigitalOceanLoadbalancerForwardingRuleRead,
+		UpdateContext: resourceDigitalOceanLoadbalancerForwardingRuleUpdate,
+		DeleteContext: resourceDigitalOceanLoadbalancerForwardingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanLoadbalancerForwardingRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the ID of the load balancer the forwarding rule is added to",
+			},
+			"entry_protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"http",
+					"https",
+					"http2",
+					"http3",
+					"tcp",
+					"udp",
+				}, false),
+				Description: "the protocol used for traffic to the load balancer",
+			},
+			"entry_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "the port on which the load balancer listens",
+			},
+			"target_protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"http",
+					"https",
+					"http2",
+					"tcp",
+					"udp",
+				}, false),
+				Description: "the protocol used for traffic from the load balancer to the backend Droplets",
+			},
+			"target_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "the port on the backend Droplets to which the load balancer sends traffic",
+			},
+			"certificate_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the name of the certificate used for SSL termination",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the ID of the certificate used for SSL termination",
+			},
+			"tls_passthrough": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether SSL encrypted traffic is passed through to the backend Droplets",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanLoadbalancerForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	rule, err := expandLoadbalancerForwardingRule(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := loadbalancerMutexKey(lbID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	log.Printf("[DEBUG] Load Balancer (%s) forwarding rule create: %#v", lbID, rule)
+	_, err = client.LoadBalancers.AddForwardingRules(ctx, lbID, *rule)
+	if err != nil {
+		return diag.Errorf("Error adding forwarding rule to Load Balancer (%s): %s", lbID, err)
+	}
+
+	d.SetId(makeLoadbalancerForwardingRuleID(lbID, rule.EntryProtocol, rule.EntryPort))
+
+	return resourceDigitalOceanLoadbalancerForwardingRuleRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanLoadbalancerForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	loadbalancer, resp, err := client.LoadBalancers.Get(ctx, lbID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] DigitalOcean Load Balancer (%s) not found", lbID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving Load Balancer: %s", err)
+	}
+
+	rule := findForwardingRule(loadbalancer.ForwardingRules, d.Get("entry_protocol").(string), d.Get("entry_port").(int))
+	if rule == nil {
+		log.Printf("[WARN] Forwarding rule (%s) not found on Load Balancer (%s)", d.Id(), lbID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("target_protocol", rule.TargetProtocol)
+	d.Set("target_port", rule.TargetPort)
+	d.Set("tls_passthrough", rule.TlsPassthrough)
+	d.Set("certificate_id", rule.CertificateID)
+
+	if rule.CertificateID != "" {
+		// The certificate ID changes when a Let's Encrypt certificate is
+		// renewed, so the name is used as the identifier in the config.
+		cert, _, err := client.Certificates.Get(ctx, rule.CertificateID)
+		if err != nil {
+			return diag.Errorf("Error retrieving certificate (%s) of forwarding rule: %s", rule.CertificateID, err)
+		}
+		d.Set("certificate_name", cert.Name)
+	} else {
+		d.Set("certificate_name", "")
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanLoadbalancerForwardingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	rule, err := expandLoadbalancerForwardingRule(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := loadbalancerMutexKey(lbID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	loadbalancer, _, err := client.LoadBalancers.Get(ctx, lbID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Load Balancer: %s", err)
+	}
+
+	// Forwarding rules can not be modified, and a load balancer can not have
+	// two rules for the same entry protocol and port, so the existing rule
+	// is removed before the new one is added, and restored if that fails.
+	existing := findForwardingRule(loadbalancer.ForwardingRules, rule.EntryProtocol, rule.EntryPort)
+	if existing != nil {
+		_, err = client.LoadBalancers.RemoveForwardingRules(ctx, lbID, *existing)
+		if err != nil {
+			return diag.Errorf("Error removing forwarding rule from Load Balancer (%s): %s", lbID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Load Balancer (%s) forwarding rule update: %#v", lbID, rule)
+	_, err = client.LoadBalancers.AddForwardingRules(ctx, lbID, *rule)
+	if err != nil {
+		if existing != nil {
+			log.Printf("[INFO] Restoring forwarding rule (%s) on Load Balancer (%s)", d.Id(), lbID)
+			if _, restoreErr := client.LoadBalancers.AddForwardingRules(ctx, lbID, *existing); restoreErr != nil {
+				return diag.Errorf("Error adding forwarding rule to Load Balancer (%s): %s; the previous rule could not be restored: %s", lbID, err, restoreErr)
+			}
+		}
+		return diag.Errorf("Error adding forwarding rule to Load Balancer (%s): %s", lbID, err)
+	}
+
+	return resourceDigitalOceanLoadbalancerForwardingRuleRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanLoadbalancerForwardingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	lbID := d.Get("load_balancer_id").(string)
+
+	key := loadbalancerMutexKey(lbID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	loadbalancer, resp, err := client.LoadBalancers.Get(ctx, lbID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving Load Balancer: %s", err)
+	}
+
+	rule := findForwardingRule(loadbalancer.ForwardingRules, d.Get("entry_protocol").(string), d.Get("entry_port").(int))
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Removing forwarding rule (%s) from Load Balancer (%s)", d.Id(), lbID)
+	resp, err = client.LoadBalancers.RemoveForwardingRules(ctx, lbID, *rule)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error removing forwarding rule from Load Balancer (%s): %s", lbID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanLoadbalancerForwardingRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 3 {
+		return nil, errors.New("must use the ID of the load balancer, the entry protocol and the entry port joined with commas (e.g. `id,https,443`)")
+	}
+
+	port, err := strconv.Atoi(s[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid entry port %q: %s", s[2], err)
+	}
+
+	d.SetId(makeLoadbalancerForwardingRuleID(s[0], s[1], port))
+	d.Set("load_balancer_id", s[0])
+	d.Set("entry_protocol", s[1])
+	d.Set("entry_port", port)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandLoadbalancerForwardingRule(client *godo.Client, d *schema.ResourceData) (*godo.ForwardingRule, error) {
+	rules, err := expandForwardingRules(client, []interface{}{
+		map[string]interface{}{
+			"entry_protocol":   d.Get("entry_protocol"),
+			"entry_port":       d.Get("entry_port"),
+			"target_protocol":  d.Get("target_protocol"),
+			"target_port":      d.Get("target_port"),
+			"tls_passthrough":  d.Get("tls_passthrough"),
+			"certificate_name": d.Get("certificate_name"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rules[0], nil
+}
+
+func makeLoadbalancerForwardingRuleID(lbID string, entryProtocol string, entryPort int) string {
+	return fmt.Sprintf("%s/forwarding_rule/%s/%d", lbID, entryProtocol, entryPort)
+}
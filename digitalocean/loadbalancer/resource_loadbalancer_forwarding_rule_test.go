@@ -0,0 +1,156 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanLoadbalancerForwardingRule_Basic(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	name := acceptance.RandomTestName()
+	privateKeyMaterial, leafCertMaterial, certChainMaterial := acceptance.GenerateTestCertMaterial(t)
+	certName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerForwardingRuleConfig(
+					certName, name, privateKeyMaterial, leafCertMaterial, certChainMaterial, 8080),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					testAccCheckDigitalOceanLoadbalancerForwardingRuleCount("digitalocean_loadbalancer.foobar", 3),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "forwarding_rule.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_loadbalancer_forwarding_rule.https", "load_balancer_id",
+						"digitalocean_loadbalancer.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer_forwarding_rule.https", "certificate_name", certName),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_loadbalancer_forwarding_rule.https", "certificate_id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer_forwarding_rule.tcp", "target_port", "8080"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer_forwarding_rule.tcp", "tls_passthrough", "false"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerForwardingRuleConfig(
+					certName, name, privateKeyMaterial, leafCertMaterial, certChainMaterial, 9090),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerForwardingRuleCount("digitalocean_loadbalancer.foobar", 3),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "forwarding_rule.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer_forwarding_rule.tcp", "target_port", "9090"),
+				),
+			},
+			{
+				// The state is empty on import, so every rule is read into
+				// forwarding_rule whatever ignore_external_forwarding_rules is
+				// set to.
+				ResourceName: "digitalocean_loadbalancer.foobar",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("Expected 1 imported state, found %d", len(states))
+					}
+					if count := states[0].Attributes["forwarding_rule.#"]; count != "3" {
+						return fmt.Errorf("Expected 3 imported forwarding rules, found %s", count)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "digitalocean_loadbalancer_forwarding_rule.tcp",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["digitalocean_loadbalancer_forwarding_rule.tcp"]
+					if !ok {
+						return "", fmt.Errorf("Not found: digitalocean_loadbalancer_forwarding_rule.tcp")
+					}
+					return fmt.Sprintf("%s,tcp,8443", rs.Primary.Attributes["load_balancer_id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanLoadbalancerForwardingRuleCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var loadbalancer godo.LoadBalancer
+		if err := testAccCheckDigitalOceanLoadbalancerExists(n, &loadbalancer)(s); err != nil {
+			return err
+		}
+
+		if len(loadbalancer.ForwardingRules) != count {
+			return fmt.Errorf("Expected %d forwarding rules, found %d: %#v",
+				count, len(loadbalancer.ForwardingRules), loadbalancer.ForwardingRules)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanLoadbalancerForwardingRuleConfig(certName string, name string, privateKeyMaterial, leafCert, certChain string, targetPort int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_certificate" "foobar" {
+  name              = "%s"
+  private_key       = <<EOF
+%s
+EOF
+  leaf_certificate  = <<EOF
+%s
+EOF
+  certificate_chain = <<EOF
+%s
+EOF
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name      = "%s"
+  region    = "nyc3"
+  size_unit = 1
+
+  ignore_external_forwarding_rules = true
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+}
+
+resource "digitalocean_loadbalancer_forwarding_rule" "https" {
+  load_balancer_id = digitalocean_loadbalancer.foobar.id
+
+  entry_port     = 443
+  entry_protocol = "https"
+
+  target_port     = 80
+  target_protocol = "http"
+
+  certificate_name = digitalocean_certificate.foobar.name
+}
+
+resource "digitalocean_loadbalancer_forwarding_rule" "tcp" {
+  load_balancer_id = digitalocean_loadbalancer.foobar.id
+
+  entry_port     = 8443
+  entry_protocol = "tcp"
+
+  target_port     = %d
+  target_protocol = "tcp"
+}`, certName, privateKeyMaterial, leafCert, certChain, name, targetPort)
+}
@@ -0,0 +1,70 @@
+package oneclick
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanOneClicks() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"slug": {
+				Type:        schema.TypeString,
+				Description: "A human-readable string that is used to uniquely identify each 1-Click application.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the 1-Click application, either droplet or kubernetes.",
+			},
+		},
+		ResultAttributeName: "one_clicks",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The type of 1-Click applications to list, either droplet or kubernetes.",
+				ValidateFunc: validation.StringInSlice([]string{"droplet", "kubernetes"}, false),
+			},
+		},
+		FlattenRecord: flattenDigitalOceanOneClick,
+		GetRecords:    getDigitalOceanOneClicks,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanOneClicks(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	oneClickType, _ := extra["type"].(string)
+
+	oneClicks, _, err := client.OneClick.List(context.Background(), oneClickType)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving 1-Click applications: %s", err)
+	}
+
+	var allOneClicks []interface{}
+	for _, oneClick := range oneClicks {
+		allOneClicks = append(allOneClicks, *oneClick)
+	}
+
+	return allOneClicks, nil
+}
+
+func flattenDigitalOceanOneClick(rawOneClick, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	oneClick, ok := rawOneClick.(godo.OneClick)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.OneClick")
+	}
+
+	return map[string]interface{}{
+		"slug": oneClick.Slug,
+		"type": oneClick.Type,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package oneclick_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanOneClicks_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanOneClicksConfigType,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_one_clicks.kubernetes", "one_clicks.0.slug"),
+					resource.TestCheckResourceAttr("data.digitalocean_one_clicks.kubernetes", "one_clicks.0.type", "kubernetes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanOneClicks_WithFilterAndSort(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanOneClicksConfigWithFilterAndSort,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_one_clicks.monitoring", "one_clicks.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_one_clicks.monitoring", "one_clicks.0.slug", "monitoring"),
+					resource.TestCheckResourceAttr("data.digitalocean_one_clicks.monitoring", "one_clicks.0.type", "kubernetes"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanOneClicksConfigType = `
+data "digitalocean_one_clicks" "kubernetes" {
+  type = "kubernetes"
+
+  sort {
+    key       = "slug"
+    direction = "asc"
+  }
+}`
+
+const testAccCheckDataSourceDigitalOceanOneClicksConfigWithFilterAndSort = `
+data "digitalocean_one_clicks" "monitoring" {
+  type = "kubernetes"
+
+  filter {
+    key    = "slug"
+    values = ["monitoring"]
+  }
+
+  sort {
+    key       = "slug"
+    direction = "desc"
+  }
+}`
@@ -5,6 +5,7 @@ import (
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/account"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/app"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/billing"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/byoipprefix"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/cdn"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
@@ -14,12 +15,14 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/dropletautoscale"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/functions"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/gradientai"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/image"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/loadbalancer"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/monitoring"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/nfs"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/oneclick"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/partnernetworkconnect"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/region"
@@ -106,6 +109,8 @@ func Provider() *schema.Provider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"digitalocean_account":                                 account.DataSourceDigitalOceanAccount(),
 			"digitalocean_app":                                     app.DataSourceDigitalOceanApp(),
+			"digitalocean_balance":                                 billing.DataSourceDigitalOceanBalance(),
+			"digitalocean_billing_history":                         billing.DataSourceDigitalOceanBillingHistory(),
 			"digitalocean_byoip_prefix_resources":                  byoipprefix.DataSourceDigitalOceanBYOIPPrefixResources(),
 			"digitalocean_byoip_prefix":                            byoipprefix.DataSourceDigitalOceanBYOIPPrefix(),
 			"digitalocean_certificate":                             certificate.DataSourceDigitalOceanCertificate(),
@@ -113,23 +118,41 @@ func Provider() *schema.Provider {
 			"digitalocean_container_registries":                    registry.DataSourceDigitalOceanContainerRegistries(),
 			"digitalocean_database_cluster":                        database.DataSourceDigitalOceanDatabaseCluster(),
 			"digitalocean_database_connection_pool":                database.DataSourceDigitalOceanDatabaseConnectionPool(),
+			"digitalocean_database_connection_pools":               database.DataSourceDigitalOceanDatabaseConnectionPools(),
 			"digitalocean_database_ca":                             database.DataSourceDigitalOceanDatabaseCA(),
+			"digitalocean_database_dbs":                            database.DataSourceDigitalOceanDatabaseDBs(),
+			"digitalocean_database_events":                         database.DataSourceDigitalOceanDatabaseEvents(),
+			"digitalocean_database_kafka_topics":                   database.DataSourceDigitalOceanDatabaseKafkaTopics(),
 			"digitalocean_database_metrics_credentials":            database.DataSourceDigitalOceanDatabaseMetricsCredentials(),
+			"digitalocean_database_opensearch_indexes":             database.DataSourceDigitalOceanDatabaseOpensearchIndexes(),
 			"digitalocean_database_replica":                        database.DataSourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_replicas":                       database.DataSourceDigitalOceanDatabaseReplicas(),
 			"digitalocean_database_user":                           database.DataSourceDigitalOceanDatabaseUser(),
+			"digitalocean_database_users":                          database.DataSourceDigitalOceanDatabaseUsers(),
 			"digitalocean_domain":                                  domain.DataSourceDigitalOceanDomain(),
 			"digitalocean_domains":                                 domain.DataSourceDigitalOceanDomains(),
 			"digitalocean_droplet":                                 droplet.DataSourceDigitalOceanDroplet(),
 			"digitalocean_droplet_autoscale":                       dropletautoscale.DataSourceDigitalOceanDropletAutoscale(),
 			"digitalocean_droplets":                                droplet.DataSourceDigitalOceanDroplets(),
+			"digitalocean_droplet_backup_policies":                 droplet.DataSourceDigitalOceanDropletBackupPolicies(),
+			"digitalocean_droplet_backups":                         droplet.DataSourceDigitalOceanDropletBackups(),
+			"digitalocean_droplet_kernels":                         droplet.DataSourceDigitalOceanDropletKernels(),
+			"digitalocean_droplet_neighbors":                       droplet.DataSourceDigitalOceanDropletNeighbors(),
 			"digitalocean_droplet_snapshot":                        snapshot.DataSourceDigitalOceanDropletSnapshot(),
 			"digitalocean_firewall":                                firewall.DataSourceDigitalOceanFirewall(),
 			"digitalocean_floating_ip":                             reservedip.DataSourceDigitalOceanFloatingIP(),
+			"digitalocean_functions_namespace":                     functions.DataSourceDigitalOceanFunctionsNamespace(),
+			"digitalocean_functions_namespaces":                    functions.DataSourceDigitalOceanFunctionsNamespaces(),
 			"digitalocean_image":                                   image.DataSourceDigitalOceanImage(),
 			"digitalocean_images":                                  image.DataSourceDigitalOceanImages(),
+			"digitalocean_invoice":                                 billing.DataSourceDigitalOceanInvoice(),
 			"digitalocean_kubernetes_cluster":                      kubernetes.DataSourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_cluster_upgrades":             kubernetes.DataSourceDigitalOceanKubernetesClusterUpgrades(),
+			"digitalocean_kubernetes_kubeconfig":                   kubernetes.DataSourceDigitalOceanKubernetesKubeconfig(),
+			"digitalocean_kubernetes_options":                      kubernetes.DataSourceDigitalOceanKubernetesOptions(),
 			"digitalocean_kubernetes_versions":                     kubernetes.DataSourceDigitalOceanKubernetesVersions(),
 			"digitalocean_loadbalancer":                            loadbalancer.DataSourceDigitalOceanLoadbalancer(),
+			"digitalocean_one_clicks":                              oneclick.DataSourceDigitalOceanOneClicks(),
 			"digitalocean_project":                                 project.DataSourceDigitalOceanProject(),
 			"digitalocean_projects":                                project.DataSourceDigitalOceanProjects(),
 			"digitalocean_record":                                  domain.DataSourceDigitalOceanRecord(),
@@ -185,7 +208,10 @@ func Provider() *schema.Provider {
 			"digitalocean_database_connection_pool":                   database.ResourceDigitalOceanDatabaseConnectionPool(),
 			"digitalocean_database_db":                                database.ResourceDigitalOceanDatabaseDB(),
 			"digitalocean_database_firewall":                          database.ResourceDigitalOceanDatabaseFirewall(),
+			"digitalocean_database_maintenance_update":                database.ResourceDigitalOceanDatabaseMaintenanceUpdate(),
+			"digitalocean_database_metrics_credentials":               database.ResourceDigitalOceanDatabaseMetricsCredentials(),
 			"digitalocean_database_replica":                           database.ResourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_replica_promotion":                 database.ResourceDigitalOceanDatabaseReplicaPromotion(),
 			"digitalocean_database_user":                              database.ResourceDigitalOceanDatabaseUser(),
 			"digitalocean_database_redis_config":                      database.ResourceDigitalOceanDatabaseRedisConfig(),
 			"digitalocean_database_valkey_config":                     database.ResourceDigitalOceanDatabaseValkeyConfig(),
@@ -194,6 +220,7 @@ func Provider() *schema.Provider {
 			"digitalocean_database_mongodb_config":                    database.ResourceDigitalOceanDatabaseMongoDBConfig(),
 			"digitalocean_database_kafka_config":                      database.ResourceDigitalOceanDatabaseKafkaConfig(),
 			"digitalocean_database_opensearch_config":                 database.ResourceDigitalOceanDatabaseOpensearchConfig(),
+			"digitalocean_database_opensearch_index_retention":        database.ResourceDigitalOceanDatabaseOpensearchIndexRetention(),
 			"digitalocean_database_kafka_topic":                       database.ResourceDigitalOceanDatabaseKafkaTopic(),
 			"digitalocean_database_kafka_schema_registry":             database.ResourceDigitalOceanDatabaseKafkaSchemaRegistry(),
 			"digitalocean_database_online_migration":                  database.ResourceDigitalOceanDatabaseOnlineMigration(),
@@ -201,14 +228,22 @@ func Provider() *schema.Provider {
 			"digitalocean_database_logsink_opensearch":                database.ResourceDigitalOceanDatabaseLogsinkOpensearch(),
 			"digitalocean_domain":                                     domain.ResourceDigitalOceanDomain(),
 			"digitalocean_droplet":                                    droplet.ResourceDigitalOceanDroplet(),
+			"digitalocean_droplet_action":                             droplet.ResourceDigitalOceanDropletAction(),
 			"digitalocean_droplet_autoscale":                          dropletautoscale.ResourceDigitalOceanDropletAutoscale(),
+			"digitalocean_droplet_group":                              droplet.ResourceDigitalOceanDropletGroup(),
 			"digitalocean_droplet_snapshot":                           snapshot.ResourceDigitalOceanDropletSnapshot(),
+			"digitalocean_droplet_tag_action":                         droplet.ResourceDigitalOceanDropletTagAction(),
 			"digitalocean_firewall":                                   firewall.ResourceDigitalOceanFirewall(),
 			"digitalocean_floating_ip":                                reservedip.ResourceDigitalOceanFloatingIP(),
 			"digitalocean_floating_ip_assignment":                     reservedip.ResourceDigitalOceanFloatingIPAssignment(),
+			"digitalocean_functions_namespace":                        functions.ResourceDigitalOceanFunctionsNamespace(),
+			"digitalocean_functions_trigger":                          functions.ResourceDigitalOceanFunctionsTrigger(),
 			"digitalocean_kubernetes_cluster":                         kubernetes.ResourceDigitalOceanKubernetesCluster(),
 			"digitalocean_kubernetes_node_pool":                       kubernetes.ResourceDigitalOceanKubernetesNodePool(),
+			"digitalocean_kubernetes_node_recycle":                    kubernetes.ResourceDigitalOceanKubernetesNodeRecycle(),
+			"digitalocean_kubernetes_one_click":                       kubernetes.ResourceDigitalOceanKubernetesOneClick(),
 			"digitalocean_loadbalancer":                               loadbalancer.ResourceDigitalOceanLoadbalancer(),
+			"digitalocean_loadbalancer_forwarding_rule":               loadbalancer.ResourceDigitalOceanLoadbalancerForwardingRule(),
 			"digitalocean_monitor_alert":                              monitoring.ResourceDigitalOceanMonitorAlert(),
 			"digitalocean_project":                                    project.ResourceDigitalOceanProject(),
 			"digitalocean_project_resources":                          project.ResourceDigitalOceanProjectResources(),
@@ -10,6 +10,7 @@ import (
 	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/domain"
 	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
+	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/functions"
 	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/image"
 	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
 	_ "github.com/digitalocean/terraform-provider-digitalocean/digitalocean/loadbalancer"
@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -43,3 +44,39 @@ func WaitForAction(client *godo.Client, action *godo.Action) error {
 	}).WaitForStateContext(context.Background())
 	return err
 }
+
+// WaitForActions waits for all of the actions to finish, waiting on at most
+// concurrency actions at a time. The returned slice holds the result of
+// waiting for each action, in the same order as actions; a nil entry means the
+// action completed successfully.
+func WaitForActions(client *godo.Client, actions []godo.Action, concurrency int) []error {
+	return RunConcurrently(len(actions), concurrency, func(i int) error {
+		return WaitForAction(client, &actions[i])
+	})
+}
+
+// RunConcurrently calls fn for each index from 0 to n-1, running at most
+// concurrency calls at a time. It returns the error from each call, indexed
+// the same way.
+func RunConcurrently(n int, concurrency int, fn func(i int) error) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, n)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,48 @@
+package util
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	t.Parallel()
+
+	var running, maxRunning int32
+	errs := RunConcurrently(10, 3, func(i int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if i%2 == 1 {
+			return fmt.Errorf("failed %d", i)
+		}
+		return nil
+	})
+
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", maxRunning)
+	}
+
+	if len(errs) != 10 {
+		t.Fatalf("expected 10 results, got %d", len(errs))
+	}
+	for i, err := range errs {
+		if i%2 == 1 {
+			if err == nil || err.Error() != fmt.Sprintf("failed %d", i) {
+				t.Errorf("expected error for call %d, got %v", i, err)
+			}
+		} else if err != nil {
+			t.Errorf("expected no error for call %d, got %v", i, err)
+		}
+	}
+}